@@ -6,6 +6,8 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 const DefaultEndpoint = "https://api.ucloud.cn"
@@ -18,6 +20,10 @@ type Config struct {
 	PrivateKey string
 	ProjectId  string
 	Region     string
+
+	// UFileEndpoint is the URL of UFile with %s for the bucket name,
+	// defaults to https://%s.<Region>.ufileos.com.
+	UFileEndpoint string
 }
 
 type Client struct {
@@ -28,6 +34,11 @@ type Client struct {
 	privateKey string
 	projectId  string
 	region     string
+
+	ufileEndpoint string
+
+	instanceTypesLock sync.Mutex
+	instanceTypes     map[string][]AvailableInstanceType
 }
 
 type Response interface {
@@ -69,12 +80,18 @@ func (c Config) Client() (*Client, error) {
 		projectId:  c.ProjectId,
 		region:     c.Region,
 		logger:     c.Logger,
+
+		ufileEndpoint: c.UFileEndpoint,
 	}
 
 	if instance.endpoint == "" {
 		instance.endpoint = DefaultEndpoint
 	}
 
+	if instance.ufileEndpoint == "" {
+		instance.ufileEndpoint = "https://%s." + instance.region + ".ufileos.com"
+	}
+
 	if instance.httpClient == nil {
 		instance.httpClient = http.DefaultClient
 	}
@@ -82,31 +99,53 @@ func (c Config) Client() (*Client, error) {
 	return instance, nil
 }
 
+// maxQueryLength is the longest query string sent with GET, requests with
+// larger params such as certificates or user data are sent with POST.
+const maxQueryLength = 2048
+
 // Get calls UCloud API. It will generate signature and append it automatically.
 func (c *Client) Get(params url.Values) (resp *http.Response, err error) {
+	return c.send(http.MethodGet, params, nil)
+}
+
+// Post calls UCloud API with params in a form body instead of the URL.
+func (c *Client) Post(params url.Values) (resp *http.Response, err error) {
+	return c.send(http.MethodPost, params, nil)
+}
+
+// send signs params and sends them with method. Values of params in
+// secrets are masked in the log.
+func (c *Client) send(method string, params url.Values, secrets map[string]bool) (*http.Response, error) {
 	params.Set("PublicKey", c.publicKey)
 	params.Set("Region", c.region)
 	if c.projectId != "" {
 		params.Set("ProjectId", c.projectId)
 	}
 
-	targetUrl := c.endpoint + "?" + params.Encode()
 	if c.logger != nil {
-		c.logger.Printf("[DEBUG] Request: %s", targetUrl)
+		c.logger.Printf("[DEBUG] Request: %s %s?%s", method, c.endpoint, redactParams(params, secrets).Encode())
 	}
 
-	targetUrl = targetUrl + "&Signature=" + GenerateSignature(params, c.privateKey)
+	body := params.Encode() + "&Signature=" + GenerateSignature(params, c.privateKey)
+	if method == http.MethodPost {
+		return c.httpClient.Post(c.endpoint, "application/x-www-form-urlencoded", strings.NewReader(body))
+	}
 
-	return c.httpClient.Get(targetUrl)
+	return c.httpClient.Get(c.endpoint + "?" + body)
 }
 
 func (c *Client) Call(req interface{}, v Response) error {
-	params, err := BuildParams(req)
+	params, secrets, err := buildParams(req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.Get(params)
+	method := http.MethodGet
+	if len(params.Encode()) > maxQueryLength {
+		method = http.MethodPost
+	}
+
+	resp, err := c.send(method, params, secrets)
 	if err != nil {
 		return err
 	}
@@ -118,7 +157,7 @@ func (c *Client) Call(req interface{}, v Response) error {
 	}
 
 	if c.logger != nil {
-		c.logger.Printf("[DEBUG] Response: %s", string(bytes))
+		c.logger.Printf("[DEBUG] Response: %s", redactResponse(bytes, v))
 	}
 
 	err = json.Unmarshal(bytes, v)
@@ -0,0 +1,26 @@
+package client
+
+// AvailableInstanceTypes returns the machine types of zone. They are only
+// fetched once per Client, as validating every ucloud_uhost in a plan would
+// otherwise call DescribeAvailableInstanceTypes for each of them.
+func (c *Client) AvailableInstanceTypes(zone string) ([]AvailableInstanceType, error) {
+	c.instanceTypesLock.Lock()
+	defer c.instanceTypesLock.Unlock()
+
+	if types, ok := c.instanceTypes[zone]; ok {
+		return types, nil
+	}
+
+	var resp DescribeAvailableInstanceTypesResponse
+	err := c.Call(&DescribeAvailableInstanceTypesRequest{Zone: zone}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.instanceTypes == nil {
+		c.instanceTypes = make(map[string][]AvailableInstanceType)
+	}
+	c.instanceTypes[zone] = resp.AvailableInstanceTypes
+
+	return resp.AvailableInstanceTypes, nil
+}
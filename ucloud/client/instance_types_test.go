@@ -0,0 +1,45 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAvailableInstanceTypesCached(t *testing.T) {
+	calls := map[string]int{}
+	hs := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		if query.Get("Action") != "DescribeAvailableInstanceTypes" {
+			t.Errorf("Unexpected action %s", query.Get("Action"))
+		}
+		calls[query.Get("Zone")]++
+		io.WriteString(rw, `{"RetCode":0,"AvailableInstanceTypes":[{"Name":"N2","Zone":"`+query.Get("Zone")+`","Status":"Normal","MachineSizes":[{"Gpu":0,"Collection":[{"Cpu":1,"Memory":[1,2,4]}]}]}]}`)
+	}))
+	defer hs.Close()
+
+	c, err := Config{
+		Endpoint:   hs.URL,
+		PublicKey:  "ucloudsomeone@example.com1296235120854146120",
+		PrivateKey: "46f09bb9fab4f12dfc160dae12273d5332b5debe",
+		Region:     "cn-bj2",
+	}.Client()
+	if err != nil {
+		t.Fatal("Error creating client ", err)
+	}
+
+	for _, zone := range []string{"cn-bj2-02", "cn-bj2-02", "cn-bj2-03"} {
+		types, err := c.AvailableInstanceTypes(zone)
+		if err != nil {
+			t.Fatal("Error describing instance types: ", err)
+		}
+		if len(types) != 1 || types[0].Zone != zone || types[0].MachineSizes[0].Collection[0].Memory[2] != 4 {
+			t.Errorf("Unexpected instance types of %s: %+v", zone, types)
+		}
+	}
+
+	if calls["cn-bj2-02"] != 1 || calls["cn-bj2-03"] != 1 {
+		t.Errorf("Expect one call per zone, got %v", calls)
+	}
+}
@@ -0,0 +1,182 @@
+package client
+
+type NATGWSubnet struct {
+	SubnetworkId string
+	Subnet       string
+	SubnetName   string
+}
+
+type NATGWIPResInfo struct {
+	OperatorName string
+	EIP          string
+}
+
+type NATGWIP struct {
+	EIPId         string
+	Weight        int
+	BandwidthType string
+	Bandwidth     int
+	IPResInfo     []NATGWIPResInfo
+}
+
+type NATGW struct {
+	NATGWId    string
+	NATGWName  string
+	Tag        string
+	Remark     string
+	CreateTime int
+	FirewallId string
+	VPCId      string
+	VPCName    string
+	SubnetSet  []NATGWSubnet
+	IPSet      []NATGWIP
+}
+
+type CreateNATGWRequest struct {
+	NATGWName     string
+	EIPIds        []string
+	FirewallId    string
+	SubnetworkIds []string
+	VPCId         string
+	Tag           string
+	Remark        string
+}
+type CreateNATGWResponse struct {
+	GeneralResponse
+	NATGWId string
+}
+
+type DescribeNATGWRequest struct {
+	NATGWIds []string
+	Offset   int
+	Limit    int
+}
+type DescribeNATGWResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []NATGW
+}
+
+type UpdateNATGWSubnetRequest struct {
+	NATGWId       string
+	SubnetworkIds []string
+}
+type UpdateNATGWSubnetResponse struct {
+	GeneralResponse
+}
+
+type DeleteNATGWRequest struct {
+	NATGWId    string
+	ReleaseEip string
+}
+type DeleteNATGWResponse struct {
+	GeneralResponse
+}
+
+// NATGWPolicy is a DNAT port forwarding rule of a NAT gateway.
+type NATGWPolicy struct {
+	NATGWId    string
+	PolicyId   string
+	PolicyName string
+	Protocol   string
+	SrcEIP     string
+	SrcEIPId   string
+	SrcPort    string
+	DstIP      string
+	DstPort    string
+}
+
+type CreateNATGWPolicyRequest struct {
+	NATGWId    string
+	Protocol   string
+	SrcEIPId   string
+	SrcPort    string
+	DstIP      string
+	DstPort    string
+	PolicyName string
+}
+type CreateNATGWPolicyResponse struct {
+	GeneralResponse
+	PolicyId string
+}
+
+type DescribeNATGWPolicyRequest struct {
+	NATGWId string
+	Offset  int
+	Limit   int
+}
+type DescribeNATGWPolicyResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []NATGWPolicy
+}
+
+type UpdateNATGWPolicyRequest struct {
+	NATGWId    string
+	PolicyId   string
+	Protocol   string
+	SrcEIPId   string
+	SrcPort    string
+	DstIP      string
+	DstPort    string
+	PolicyName string
+}
+type UpdateNATGWPolicyResponse struct {
+	GeneralResponse
+}
+
+type DeleteNATGWPolicyRequest struct {
+	NATGWId  string
+	PolicyId string
+}
+type DeleteNATGWPolicyResponse struct {
+	GeneralResponse
+}
+
+type SnatRule struct {
+	SourceIp     string
+	SnatIp       string
+	SubnetworkId string
+	Name         string
+}
+
+type CreateSnatRuleRequest struct {
+	NATGWId  string
+	SourceIp string
+	SnatIp   string
+	Name     string
+}
+type CreateSnatRuleResponse struct {
+	GeneralResponse
+}
+
+type DescribeSnatRuleRequest struct {
+	NATGWId  string
+	SourceIp string
+	SnatIp   string
+	Offset   int
+	Limit    int
+}
+type DescribeSnatRuleResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []SnatRule
+}
+
+type UpdateSnatRuleRequest struct {
+	NATGWId  string
+	SourceIp string
+	SnatIp   string
+	Name     string
+}
+type UpdateSnatRuleResponse struct {
+	GeneralResponse
+}
+
+type DeleteSnatRuleRequest struct {
+	NATGWId  string
+	SourceIp string
+}
+type DeleteSnatRuleResponse struct {
+	GeneralResponse
+}
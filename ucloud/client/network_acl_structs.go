@@ -0,0 +1,140 @@
+package client
+
+type NetworkAclEntry struct {
+	EntryId     string
+	Priority    int
+	Direction   string
+	IpProtocol  string
+	CidrBlock   string
+	PortRange   string
+	EntryAction string
+	Description string
+	TargetType  int
+	CreateTime  int
+	UpdateTime  int
+}
+
+type NetworkAclAssociation struct {
+	AssociationId string
+	AclId         string
+	SubnetworkId  string
+	CreateTime    int
+}
+
+type NetworkAcl struct {
+	AclId        string
+	AclName      string
+	Description  string
+	VpcId        string
+	Entries      []NetworkAclEntry
+	Associations []NetworkAclAssociation
+	CreateTime   int
+	UpdateTime   int
+}
+
+type CreateNetworkAclRequest struct {
+	VpcId       string
+	AclName     string
+	Description string
+}
+type CreateNetworkAclResponse struct {
+	GeneralResponse
+	AclId string
+}
+
+type DescribeNetworkAclRequest struct {
+	VpcId  string
+	Offset int
+	Limit  int
+}
+type DescribeNetworkAclResponse struct {
+	GeneralResponse
+	AclList []NetworkAcl
+}
+
+type UpdateNetworkAclRequest struct {
+	AclId       string
+	AclName     string
+	Description string
+}
+type UpdateNetworkAclResponse struct {
+	GeneralResponse
+}
+
+type DeleteNetworkAclRequest struct {
+	AclId string
+}
+type DeleteNetworkAclResponse struct {
+	GeneralResponse
+}
+
+type CreateNetworkAclEntryRequest struct {
+	AclId       string
+	Priority    int
+	Direction   string
+	IpProtocol  string
+	CidrBlock   string
+	PortRange   string
+	EntryAction string
+	Description string
+}
+type CreateNetworkAclEntryResponse struct {
+	GeneralResponse
+	EntryId string
+}
+
+type DescribeNetworkAclEntryRequest struct {
+	AclId string
+}
+type DescribeNetworkAclEntryResponse struct {
+	GeneralResponse
+	EntryList []NetworkAclEntry
+}
+
+type UpdateNetworkAclEntryRequest struct {
+	AclId       string
+	EntryId     string
+	Priority    int
+	Direction   string
+	IpProtocol  string
+	CidrBlock   string
+	PortRange   string
+	EntryAction string
+	Description string
+}
+type UpdateNetworkAclEntryResponse struct {
+	GeneralResponse
+}
+
+type DeleteNetworkAclEntryRequest struct {
+	AclId   string
+	EntryId string
+}
+type DeleteNetworkAclEntryResponse struct {
+	GeneralResponse
+}
+
+type CreateNetworkAclAssociationRequest struct {
+	AclId        string
+	SubnetworkId string
+}
+type CreateNetworkAclAssociationResponse struct {
+	GeneralResponse
+	AssociationId string
+}
+
+type DescribeNetworkAclAssociationBySubnetRequest struct {
+	SubnetworkId string
+}
+type DescribeNetworkAclAssociationBySubnetResponse struct {
+	GeneralResponse
+	Association *NetworkAclAssociation
+}
+
+type DeleteNetworkAclAssociationRequest struct {
+	AclId        string
+	SubnetworkId string
+}
+type DeleteNetworkAclAssociationResponse struct {
+	GeneralResponse
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"reflect"
@@ -64,18 +65,38 @@ func BuildParams(req interface{}) (url.Values, error) {
 }
 
 func AddParams(params url.Values, req interface{}) error {
+	return addParams(params, nil, req)
+}
+
+// buildParams is BuildParams which also returns the names of the params
+// from fields tagged with Secret:"true", which must not be logged.
+func buildParams(req interface{}) (url.Values, map[string]bool, error) {
+	ret := url.Values{}
+	secrets := map[string]bool{}
+	err := addParams(ret, secrets, req)
+	return ret, secrets, err
+}
+
+func addParams(params url.Values, secrets map[string]bool, req interface{}) error {
 	val := reflect.ValueOf(req)
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
 
-	valType := val.Type()
-	typeName := valType.Name()
+	typeName := val.Type().Name()
 	action := strings.TrimSuffix(typeName, "Request")
 	if len(action) != len(typeName) {
 		params.Set("Action", action)
 	}
 
+	return addFields(params, secrets, "", val)
+}
+
+// addFields adds the fields of the struct val, prefixing their names with
+// prefix. Slices of structs are added as Name.N.Field. Names of secret
+// params are recorded in secrets unless it is nil.
+func addFields(params url.Values, secrets map[string]bool, prefix string, val reflect.Value) error {
+	valType := val.Type()
 	for i := 0; i < val.NumField(); i++ {
 		typeField := valType.Field(i)
 		field := val.Field(i)
@@ -91,16 +112,30 @@ func AddParams(params url.Values, req interface{}) error {
 		if fieldName == "" {
 			fieldName = typeField.Name
 		}
+		fieldName = prefix + fieldName
+		secret := secrets != nil && typeField.Tag.Get("Secret") == "true"
 
 		if fieldValue, ok := parameterize(field); ok {
 			if fieldValue != "" {
 				params.Set(fieldName, fieldValue)
+				if secret {
+					secrets[fieldName] = true
+				}
 			}
 		} else if fieldKind == reflect.Slice {
 			for j := 0; j < field.Len(); j++ {
 				elem := field.Index(j)
+				elemName := fieldName + "." + strconv.Itoa(j)
 				if elemValue, ok := parameterize(elem); ok {
-					params.Set(fieldName+"."+strconv.Itoa(j), elemValue)
+					params.Set(elemName, elemValue)
+					if secret {
+						secrets[elemName] = true
+					}
+				} else if elem.Kind() == reflect.Struct {
+					err := addFields(params, secrets, elemName+".", elem)
+					if err != nil {
+						return err
+					}
 				} else {
 					return fmt.Errorf("Cannot convert %s to params in slice", elem.Kind())
 				}
@@ -112,3 +147,53 @@ func AddParams(params url.Values, req interface{}) error {
 
 	return nil
 }
+
+// redactParams returns a copy of params with the values of secrets masked.
+func redactParams(params url.Values, secrets map[string]bool) url.Values {
+	ret := url.Values{}
+	for k, v := range params {
+		if secrets[k] {
+			ret.Set(k, "<redacted>")
+		} else {
+			ret[k] = v
+		}
+	}
+
+	return ret
+}
+
+// redactResponse returns body for logging, with the values of the fields of
+// the response v tagged with Secret:"true" masked.
+func redactResponse(body []byte, v interface{}) string {
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	var secrets []string
+	valType := val.Type()
+	for i := 0; i < valType.NumField(); i++ {
+		if valType.Field(i).Tag.Get("Secret") == "true" {
+			secrets = append(secrets, valType.Field(i).Name)
+		}
+	}
+	if len(secrets) == 0 {
+		return string(body)
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return "<redacted>"
+	}
+	for _, name := range secrets {
+		if _, ok := fields[name]; ok {
+			fields[name] = "<redacted>"
+		}
+	}
+	redacted, err := json.Marshal(fields)
+	if err != nil {
+		return "<redacted>"
+	}
+
+	return string(redacted)
+}
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"crypto/hmac"
 	"crypto/sha1"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/url"
@@ -29,3 +31,12 @@ func GenerateSignature(params url.Values, privateKey string) string {
 
 	return fmt.Sprintf("%x", hasher.Sum(nil))
 }
+
+// GenerateUFileSignature signs a UFile request, which uses HMAC-SHA1 on the
+// string to sign built by the caller instead of the API parameters.
+func GenerateUFileSignature(stringToSign, privateKey string) string {
+	mac := hmac.New(sha1.New, []byte(privateKey))
+	io.WriteString(mac, stringToSign)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -54,3 +54,15 @@ func TestSignatureGenerateSampleSignature(t *testing.T) {
 		t.Error("Failed the sample signautre: ", result)
 	}
 }
+
+func TestSignatureGenerateUFileSignature(t *testing.T) {
+	privateKey := "46f09bb9fab4f12dfc160dae12273d5332b5debe"
+	stringToSign := "PUT\n\ntext/plain\nMon, 02 Jan 2006 15:04:05 GMT\n/bucket/hello.txt"
+
+	expected := "xtHvVGYIWlqbfVR0/7IPsK5C7pI="
+	result := GenerateUFileSignature(stringToSign, privateKey)
+
+	if result != expected {
+		t.Error("Failed the UFile signature: ", result)
+	}
+}
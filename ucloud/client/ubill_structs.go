@@ -0,0 +1,26 @@
+package client
+
+type ModifyAutoRenewFlagRequest struct {
+	ResourceId string
+	Flag       string
+}
+type ModifyAutoRenewFlagResponse struct {
+	GeneralResponse
+}
+
+type ModifyResourceChargeTypeRequest struct {
+	ResourceId string
+	ChargeType string
+	Quantity   int
+}
+type ModifyResourceChargeTypeResponse struct {
+	GeneralResponse
+}
+
+type RenewResourceRequest struct {
+	ResourceId string
+	Quantity   int
+}
+type RenewResourceResponse struct {
+	GeneralResponse
+}
@@ -0,0 +1,204 @@
+package client
+
+type UDBInstance struct {
+	DBId         string
+	Name         string
+	Zone         string
+	DBTypeId     string
+	ParamGroupId int
+	AdminUser    string
+	VirtualIP    string
+	Port         int
+	SrcDBId      string
+	State        string
+	InstanceMode string
+	ChargeType   string
+	MemoryLimit  int
+	DiskSpace    int
+	Role         string
+	VPCId        string
+	SubnetId     string
+	Tag          string
+	CreateTime   int
+	ModifyTime   int
+	ExpiredTime  int
+
+	BackupCount     int
+	BackupBeginTime int
+	BackupDate      string
+}
+
+type CreateUDBInstanceRequest struct {
+	Zone          string
+	Name          string
+	AdminUser     string
+	AdminPassword string `Secret:"true"`
+	DBTypeId      string
+	Port          int
+	DiskSpace     int
+	MemoryLimit   int
+	ParamGroupId  int
+	InstanceMode  string
+	ChargeType    string
+	Quantity      int
+	VPCId         string
+	SubnetId      string
+}
+type CreateUDBInstanceResponse struct {
+	GeneralResponse
+	DBId string
+}
+
+type DescribeUDBInstanceRequest struct {
+	Zone      string
+	ClassType string
+	DBId      string
+	Offset    int
+	Limit     int
+}
+type DescribeUDBInstanceResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []UDBInstance
+}
+
+type ModifyUDBInstanceNameRequest struct {
+	Zone string
+	DBId string
+	Name string
+}
+type ModifyUDBInstanceNameResponse struct {
+	GeneralResponse
+}
+
+type ModifyUDBInstancePasswordRequest struct {
+	Zone     string
+	DBId     string
+	Password string `Secret:"true"`
+}
+type ModifyUDBInstancePasswordResponse struct {
+	GeneralResponse
+}
+
+type ResizeUDBInstanceRequest struct {
+	Zone        string
+	DBId        string
+	MemoryLimit int
+	DiskSpace   int
+}
+type ResizeUDBInstanceResponse struct {
+	GeneralResponse
+}
+
+type StartUDBInstanceRequest struct {
+	Zone string
+	DBId string
+}
+type StartUDBInstanceResponse struct {
+	GeneralResponse
+}
+
+type StopUDBInstanceRequest struct {
+	Zone string
+	DBId string
+}
+type StopUDBInstanceResponse struct {
+	GeneralResponse
+}
+
+type DeleteUDBInstanceRequest struct {
+	Zone string
+	DBId string
+}
+type DeleteUDBInstanceResponse struct {
+	GeneralResponse
+}
+
+// UpdateUDBInstanceBackupStrategyRequest sets the daily backup window.
+// BackupTime is the starting hour, which is a string so that midnight is
+// not dropped as a zero value. BackupDate marks the weekdays to back up from
+// Sunday to Saturday, such as 0111110 for workdays.
+type UpdateUDBInstanceBackupStrategyRequest struct {
+	Zone        string
+	DBId        string
+	BackupTime  string
+	BackupDate  string
+	BackupCount int
+}
+type UpdateUDBInstanceBackupStrategyResponse struct {
+	GeneralResponse
+}
+
+type CreateUDBSlaveRequest struct {
+	Zone        string
+	SrcId       string
+	Name        string
+	Port        int
+	MemoryLimit int
+	DiskSpace   int
+}
+type CreateUDBSlaveResponse struct {
+	GeneralResponse
+	DBId string
+}
+
+type UDBParamMember struct {
+	Key        string
+	Value      string
+	ValueType  int
+	AllowedVal string
+	ApplyType  int
+	Modifiable bool
+}
+
+type UDBParamGroup struct {
+	GroupId     int
+	GroupName   string
+	DBTypeId    string
+	Description string
+	Modifiable  bool
+	ParamMember []UDBParamMember
+}
+
+type CreateUDBParamGroupRequest struct {
+	Zone        string
+	GroupName   string
+	SrcGroupId  int
+	DBTypeId    string
+	Description string
+}
+type CreateUDBParamGroupResponse struct {
+	GeneralResponse
+	GroupId int
+}
+
+type DescribeUDBParamGroupRequest struct {
+	Zone    string
+	GroupId int
+	Offset  int
+	Limit   int
+}
+type DescribeUDBParamGroupResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []UDBParamGroup
+}
+
+// UpdateUDBParamGroupRequest changes a single parameter of a group.
+type UpdateUDBParamGroupRequest struct {
+	Zone    string
+	GroupId int
+	Key     string
+	Value   string
+}
+type UpdateUDBParamGroupResponse struct {
+	GeneralResponse
+}
+
+type DeleteUDBParamGroupRequest struct {
+	Zone    string
+	GroupId int
+}
+type DeleteUDBParamGroupResponse struct {
+	GeneralResponse
+}
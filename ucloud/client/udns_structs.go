@@ -0,0 +1,161 @@
+package client
+
+import (
+	"strings"
+)
+
+type UDNSZoneVPCInfo struct {
+	VPCId        string
+	VPCType      string
+	VPCProjectId string
+	Name         string
+	Network      []string
+}
+
+type UDNSZone struct {
+	DNSZoneId          string
+	DNSZoneName        string
+	Type               string
+	IsRecursionEnabled string
+	Remark             string
+	Tag                string
+	VPCInfos           []UDNSZoneVPCInfo
+	RecordInfos        []string
+	ChargeType         string
+	CreateTime         int
+	ExpireTime         int
+}
+
+type CreateUDNSZoneRequest struct {
+	DNSZoneName        string
+	Type               string
+	IsRecursionEnabled string
+	Remark             string
+	Tag                string
+	ChargeType         string
+	Quantity           int
+}
+type CreateUDNSZoneResponse struct {
+	GeneralResponse
+	DNSZoneId string
+}
+
+type DescribeUDNSZoneRequest struct {
+	DNSZoneIds []string
+	Offset     int
+	Limit      int
+}
+type DescribeUDNSZoneResponse struct {
+	GeneralResponse
+	TotalCount   int
+	DNSZoneInfos []UDNSZone
+}
+
+type ModifyUDNSZoneRemarkRequest struct {
+	DNSZoneId string
+	Remark    string
+}
+type ModifyUDNSZoneRemarkResponse struct {
+	GeneralResponse
+}
+
+type BindUDNSZoneVPCRequest struct {
+	DNSZoneId string
+	VPCId     string
+}
+type BindUDNSZoneVPCResponse struct {
+	GeneralResponse
+}
+
+type UnbindUDNSZoneVPCRequest struct {
+	DNSZoneId string
+	VPCId     string
+}
+type UnbindUDNSZoneVPCResponse struct {
+	GeneralResponse
+}
+
+type DeleteUDNSZoneRequest struct {
+	DNSZoneId string
+}
+type DeleteUDNSZoneResponse struct {
+	GeneralResponse
+}
+
+type UDNSRecordValue struct {
+	Data      string
+	Weight    int
+	IsEnabled int
+}
+
+type UDNSRecord struct {
+	RecordId  string
+	Name      string
+	Type      string
+	TTL       int
+	ValueType string
+	ValueSet  []UDNSRecordValue
+	Remark    string
+}
+
+// UDNSRecordValues is the Value of a record, each value is formatted as
+// value|weight|enabled and joined by commas. All values are enabled with the
+// same weight, so they are answered together.
+type UDNSRecordValues []string
+
+func (values UDNSRecordValues) Parameterize() (string, error) {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, value+"|1|1")
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// CreateUDNSRecordRequest creates a record. ValueType is Normal for a single
+// value or Multivalue for more.
+type CreateUDNSRecordRequest struct {
+	DNSZoneId string
+	Name      string
+	Type      string
+	Value     UDNSRecordValues
+	ValueType string
+	TTL       int
+	Remark    string
+}
+type CreateUDNSRecordResponse struct {
+	GeneralResponse
+	DNSRecordId string
+}
+
+type DescribeUDNSRecordRequest struct {
+	DNSZoneId string
+	RecordIds []string
+	Offset    int
+	Limit     int
+}
+type DescribeUDNSRecordResponse struct {
+	GeneralResponse
+	TotalCount  int
+	RecordInfos []UDNSRecord
+}
+
+type ModifyUDNSRecordRequest struct {
+	DNSZoneId string
+	RecordId  string
+	Value     UDNSRecordValues
+	ValueType string
+	TTL       int
+	Remark    string
+}
+type ModifyUDNSRecordResponse struct {
+	GeneralResponse
+}
+
+type DeleteUDNSRecordsRequest struct {
+	DNSZoneId string
+	RecordIds []string
+}
+type DeleteUDNSRecordsResponse struct {
+	GeneralResponse
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestCreateUDNSRecordRequest(t *testing.T) {
+	req := &CreateUDNSRecordRequest{
+		DNSZoneId: "udnszone-foo",
+		Name:      "www",
+		Type:      "A",
+		Value:     UDNSRecordValues{"10.9.0.1", "10.9.0.2"},
+		ValueType: "Multivalue",
+		TTL:       60,
+	}
+
+	params, err := BuildParams(req)
+	if err != nil {
+		t.Fatal("Failed to build params: ", err)
+	}
+
+	cases := []struct{ Arg, Expectation string }{
+		{"Action", "CreateUDNSRecord"},
+		{"DNSZoneId", "udnszone-foo"},
+		{"Value", "10.9.0.1|1|1,10.9.0.2|1|1"},
+		{"ValueType", "Multivalue"},
+		{"TTL", "60"},
+	}
+
+	for _, tc := range cases {
+		real := params.Get(tc.Arg)
+		if real != tc.Expectation {
+			t.Errorf("Expect %s to be %s but got: %s", tc.Arg, tc.Expectation, real)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UFileObject is the metadata of a file in a UFile bucket.
+type UFileObject struct {
+	ContentType   string
+	ContentLength int64
+	ETag          string
+}
+
+type ufileErrorResponse struct {
+	RetCode int
+	ErrMsg  string
+}
+
+// PutFile uploads body as key of bucket and returns its ETag.
+func (c *Client) PutFile(bucket, key, contentType string, body []byte) (string, error) {
+	resp, err := c.ufileDo("PutFile", "PUT", bucket, key, contentType, body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return strings.Trim(resp.Header.Get("ETag"), `"`), nil
+}
+
+// HeadFile returns the metadata of key, or nil if it does not exist.
+func (c *Client) HeadFile(bucket, key string) (*UFileObject, error) {
+	resp, err := c.ufileDo("HeadFile", "HEAD", bucket, key, "", nil)
+	if err != nil {
+		if brce, ok := err.(*BadRetCodeError); ok && brce.RetCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	length, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	return &UFileObject{
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: length,
+		ETag:          strings.Trim(resp.Header.Get("ETag"), `"`),
+	}, nil
+}
+
+// DeleteFile deletes key from bucket. Deleting a missing file is not an
+// error.
+func (c *Client) DeleteFile(bucket, key string) error {
+	resp, err := c.ufileDo("DeleteFile", "DELETE", bucket, key, "", nil)
+	if err != nil {
+		if brce, ok := err.(*BadRetCodeError); ok && brce.RetCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ufileDo sends a signed request to the UFile endpoint of bucket. Unlike the
+// API, UFile signs the request line and headers in the Authorization header.
+// Failures are returned as BadRetCodeError, using the HTTP status code when
+// the body carries no RetCode, such as for HEAD.
+func (c *Client) ufileDo(action, method, bucket, key, contentType string, body []byte) (*http.Response, error) {
+	targetUrl := fmt.Sprintf(c.ufileEndpoint, bucket) + "/" + (&url.URL{Path: key}).EscapedPath()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, targetUrl, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now().UTC().Format(http.TimeFormat)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Date", date)
+
+	stringToSign := method + "\n\n" + contentType + "\n" + date + "\n/" + bucket + "/" + key
+	req.Header.Set("Authorization", "UCloud "+c.publicKey+":"+GenerateUFileSignature(stringToSign, c.privateKey))
+
+	if c.logger != nil {
+		c.logger.Printf("[DEBUG] UFile request: %s %s", method, targetUrl)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		bytes, _ := ioutil.ReadAll(resp.Body)
+
+		if c.logger != nil {
+			c.logger.Printf("[DEBUG] UFile response: %s %s", resp.Status, string(bytes))
+		}
+
+		var ufileErr ufileErrorResponse
+		if json.Unmarshal(bytes, &ufileErr) != nil || ufileErr.RetCode == 0 {
+			ufileErr.RetCode = resp.StatusCode
+			ufileErr.ErrMsg = resp.Status
+		}
+		// a missing file is reported with its own RetCode but always 404
+		if resp.StatusCode == http.StatusNotFound {
+			ufileErr.RetCode = http.StatusNotFound
+		}
+
+		return nil, &BadRetCodeError{
+			Action:  action,
+			RetCode: ufileErr.RetCode,
+			Message: ufileErr.ErrMsg,
+		}
+	}
+
+	return resp, nil
+}
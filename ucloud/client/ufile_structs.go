@@ -0,0 +1,55 @@
+package client
+
+type UFileBucketDomain struct {
+	Src       []string
+	Cdn       []string
+	CustomSrc []string
+	CustomCdn []string
+}
+
+type UFileBucket struct {
+	BucketId   string
+	BucketName string
+	Type       string
+	Region     string
+	Domain     UFileBucketDomain
+	CreateTime int
+	ModifyTime int
+}
+
+type CreateBucketRequest struct {
+	BucketName string
+	Type       string
+}
+type CreateBucketResponse struct {
+	GeneralResponse
+	BucketId   string
+	BucketName string
+}
+
+type DescribeBucketRequest struct {
+	BucketName string
+	Offset     int
+	Limit      int
+}
+type DescribeBucketResponse struct {
+	GeneralResponse
+	DataSet []UFileBucket
+}
+
+type UpdateBucketRequest struct {
+	BucketName string
+	Type       string
+}
+type UpdateBucketResponse struct {
+	GeneralResponse
+	BucketId string
+}
+
+type DeleteBucketRequest struct {
+	BucketName string
+}
+type DeleteBucketResponse struct {
+	GeneralResponse
+	BucketId string
+}
@@ -0,0 +1,81 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client/ufiletest"
+)
+
+const (
+	testPublicKey  = "ucloudsomeone@example.com1296235120854146120"
+	testPrivateKey = "46f09bb9fab4f12dfc160dae12273d5332b5debe"
+)
+
+func TestClientUFile(t *testing.T) {
+	hs := ufiletest.NewServer(testPublicKey, testPrivateKey)
+	defer hs.Close()
+
+	c, err := client.Config{
+		PublicKey:     testPublicKey,
+		PrivateKey:    testPrivateKey,
+		Region:        "cn-bj2",
+		UFileEndpoint: hs.Endpoint(),
+	}.Client()
+	if err != nil {
+		t.Fatal("Error creating client ", err)
+	}
+
+	etag, err := c.PutFile("bucket", "assets/hello world.txt", "text/plain", []byte("hello"))
+	if err != nil {
+		t.Fatal("Error putting file: ", err)
+	}
+	if etag == "" {
+		t.Error("Expect ETag of the file")
+	}
+	if body, ok := hs.File("bucket", "assets/hello world.txt"); !ok || string(body) != "hello" {
+		t.Errorf("Expect file uploaded, got %q", body)
+	}
+
+	object, err := c.HeadFile("bucket", "assets/hello world.txt")
+	if err != nil {
+		t.Fatal("Error heading file: ", err)
+	}
+	if object == nil || object.ETag != etag || object.ContentType != "text/plain" || object.ContentLength != 5 {
+		t.Errorf("Unexpected file metadata %+v", object)
+	}
+
+	err = c.DeleteFile("bucket", "assets/hello world.txt")
+	if err != nil {
+		t.Fatal("Error deleting file: ", err)
+	}
+	object, err = c.HeadFile("bucket", "assets/hello world.txt")
+	if err != nil || object != nil {
+		t.Errorf("Expect file deleted, got %+v, %v", object, err)
+	}
+	err = c.DeleteFile("bucket", "assets/hello world.txt")
+	if err != nil {
+		t.Error("Expect deleting a missing file to succeed, got ", err)
+	}
+}
+
+func TestClientUFileBadSignature(t *testing.T) {
+	hs := ufiletest.NewServer(testPublicKey, testPrivateKey)
+	defer hs.Close()
+
+	c, err := client.Config{
+		PublicKey:     testPublicKey,
+		PrivateKey:    "wrong",
+		Region:        "cn-bj2",
+		UFileEndpoint: hs.Endpoint(),
+	}.Client()
+	if err != nil {
+		t.Fatal("Error creating client ", err)
+	}
+
+	_, err = c.PutFile("bucket", "hello.txt", "text/plain", []byte("hello"))
+	brce, ok := err.(*client.BadRetCodeError)
+	if !ok || brce.RetCode != -148643 || brce.Action != "PutFile" {
+		t.Errorf("Expect BadRetCodeError on signature, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+// Package ufiletest provides a fake UFile server which keeps files in memory
+// and verifies the request signature, for testing code that uploads files
+// without a UFile bucket.
+package ufiletest
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+)
+
+type file struct {
+	contentType string
+	body        []byte
+	etag        string
+}
+
+type Server struct {
+	*httptest.Server
+
+	publicKey  string
+	privateKey string
+
+	mu    sync.Mutex
+	files map[string]file
+}
+
+// NewServer starts a server accepting requests signed with the given keys.
+// The caller should call Close when finished.
+func NewServer(publicKey, privateKey string) *Server {
+	s := &Server{
+		publicKey:  publicKey,
+		privateKey: privateKey,
+		files:      make(map[string]file),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Endpoint returns the value for client.Config.UFileEndpoint. The fake
+// server addresses buckets by the first path segment instead of the host.
+func (s *Server) Endpoint() string {
+	return s.URL + "/%s"
+}
+
+// File returns the content of key in bucket.
+func (s *Server) File(bucket, key string) ([]byte, bool) {
+	f, ok := s.file(bucket, key)
+	return f.body, ok
+}
+
+// PutFile stores a file bypassing the signature, such as to simulate a
+// change made outside of the code under test.
+func (s *Server) PutFile(bucket, key, contentType string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files[bucket+"/"+key] = file{
+		contentType: contentType,
+		body:        body,
+		etag:        fmt.Sprintf("%x", md5.Sum(body)),
+	}
+}
+
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		writeError(rw, http.StatusBadRequest, -148654, "invalid bucket or key")
+		return
+	}
+	bucket, key := parts[0], parts[1]
+
+	stringToSign := req.Method + "\n" + req.Header.Get("Content-MD5") + "\n" + req.Header.Get("Content-Type") + "\n" +
+		req.Header.Get("Date") + "\n/" + bucket + "/" + key
+	expected := "UCloud " + s.publicKey + ":" + client.GenerateUFileSignature(stringToSign, s.privateKey)
+	if req.Header.Get("Authorization") != expected {
+		writeError(rw, http.StatusForbidden, -148643, "signature not match")
+		return
+	}
+
+	switch req.Method {
+	case "PUT":
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			writeError(rw, http.StatusBadRequest, -148654, err.Error())
+			return
+		}
+		s.PutFile(bucket, key, req.Header.Get("Content-Type"), body)
+		f, _ := s.file(bucket, key)
+		rw.Header().Set("ETag", `"`+f.etag+`"`)
+		rw.WriteHeader(http.StatusOK)
+
+	case "GET", "HEAD":
+		f, ok := s.file(bucket, key)
+		if !ok {
+			writeError(rw, http.StatusNotFound, -148660, "file not exist")
+			return
+		}
+		rw.Header().Set("Content-Type", f.contentType)
+		rw.Header().Set("Content-Length", strconv.Itoa(len(f.body)))
+		rw.Header().Set("ETag", `"`+f.etag+`"`)
+		rw.WriteHeader(http.StatusOK)
+		if req.Method == "GET" {
+			rw.Write(f.body)
+		}
+
+	case "DELETE":
+		s.mu.Lock()
+		_, ok := s.files[bucket+"/"+key]
+		delete(s.files, bucket+"/"+key)
+		s.mu.Unlock()
+		if !ok {
+			writeError(rw, http.StatusNotFound, -148660, "file not exist")
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(rw, http.StatusMethodNotAllowed, -148654, "method not allowed")
+	}
+}
+
+func (s *Server) file(bucket, key string) (file, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.files[bucket+"/"+key]
+	return f, ok
+}
+
+func writeError(rw http.ResponseWriter, status, retCode int, message string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	io.WriteString(rw, fmt.Sprintf(`{"RetCode":%d,"ErrMsg":%q}`, retCode, message))
+}
@@ -8,10 +8,12 @@ type UHostIP struct {
 }
 
 type UHostDisk struct {
-	Type   string
-	DiskId string
-	Drive  string
-	Size   int
+	Type     string
+	DiskType string
+	IsBoot   string
+	DiskId   string
+	Drive    string
+	Size     int
 }
 
 type UHostInstance struct {
@@ -36,6 +38,8 @@ type UHostInstance struct {
 	IPSet          []UHostIP
 	NetCapability  string
 	NetworkState   string
+	IsolationGroup string
+	HotplugFeature bool
 }
 
 type DescribeUHostInstanceRequest struct {
@@ -56,7 +60,7 @@ type CreateUHostInstanceRequest struct {
 	Zone            string
 	ImageId         string
 	LoginMode       string
-	Password        string
+	Password        string `Secret:"true"`
 	KeyPair         string
 	CPU             int
 	Memory          int
@@ -73,6 +77,16 @@ type CreateUHostInstanceRequest struct {
 	CouponId        string
 	ProjectId       int
 	BootDiskSpace   int
+	IsolationGroup  string
+	UserData        string `Secret:"true"`
+	Disks           []CreateUHostInstanceDisk
+}
+
+// CreateUHostInstanceDisk is sent as Disks.N.*, the boot disk goes first.
+type CreateUHostInstanceDisk struct {
+	IsBoot string
+	Type   string
+	Size   int
 }
 
 type CreateUHostInstanceResponse struct {
@@ -142,10 +156,94 @@ type ResizeUHostInstanceResponse struct {
 	GeneralResponse
 }
 
+type GetUHostInstancePriceRequest struct {
+	Zone          string
+	ImageId       string
+	CPU           int
+	Memory        int
+	Count         int
+	ChargeType    string
+	Quantity      int
+	UHostType     string
+	NetCapability string
+	Disks         []CreateUHostInstanceDisk
+}
+type GetUHostInstancePriceResponse struct {
+	GeneralResponse
+	PriceSet []UHostPrice
+}
+
+type UHostPrice struct {
+	ChargeType    string
+	Price         float64
+	OriginalPrice float64
+}
+
+type UHostMachineSize struct {
+	Gpu        int
+	Collection []UHostMachineSizeCollection
+}
+
+// UHostMachineSizeCollection lists the memory in GB allowed with Cpu cores.
+type UHostMachineSizeCollection struct {
+	Cpu    int
+	Memory []float64
+}
+
+type AvailableInstanceType struct {
+	Name         string
+	Zone         string
+	Status       string
+	MachineClass string
+	MachineSizes []UHostMachineSize
+}
+
+type DescribeAvailableInstanceTypesRequest struct {
+	Zone string
+}
+type DescribeAvailableInstanceTypesResponse struct {
+	GeneralResponse
+	AvailableInstanceTypes []AvailableInstanceType
+}
+
+type GetUHostInstanceVncInfoRequest struct {
+	UHostId string
+	Zone    string
+}
+type GetUHostInstanceVncInfoResponse struct {
+	GeneralResponse
+	UHostId     string
+	VncIP       string
+	VncPort     int
+	VncPassword string `Secret:"true"`
+}
+
+type ResizeAttachedDiskRequest struct {
+	UHostId   string
+	Zone      string
+	DiskId    string
+	DiskSpace int
+}
+type ResizeAttachedDiskResponse struct {
+	GeneralResponse
+}
+
+type ReinstallUHostInstanceRequest struct {
+	UHostId     string
+	Zone        string
+	ImageId     string
+	Password    string `Secret:"true"`
+	ReserveDisk string
+}
+type ReinstallUHostInstanceResponse struct {
+	GeneralResponse
+	UHostId string
+}
+
 type ResetUHostInstancePasswordRequest struct {
 	UHostId  string
 	Zone     string
-	Password string
+	Password string `Secret:"true"`
 }
 type ResetUHostInstancePasswordResponse struct {
 	GeneralResponse
@@ -178,3 +276,42 @@ type DescribeImageResponse struct {
 	TotalCount int
 	ImageSet   []*UHostImage
 }
+
+type IsolationGroupSpread struct {
+	Zone       string
+	UHostCount int
+}
+
+type IsolationGroup struct {
+	GroupId       string
+	GroupName     string
+	Remark        string
+	SpreadInfoSet []IsolationGroupSpread
+}
+
+type CreateIsolationGroupRequest struct {
+	GroupName string
+	Remark    string
+}
+type CreateIsolationGroupResponse struct {
+	GeneralResponse
+	GroupId string
+}
+
+type DescribeIsolationGroupRequest struct {
+	GroupId string
+	Offset  int
+	Limit   int
+}
+type DescribeIsolationGroupResponse struct {
+	GeneralResponse
+	TotalCount        int
+	IsolationGroupSet []IsolationGroup
+}
+
+type DeleteIsolationGroupRequest struct {
+	GroupId string
+}
+type DeleteIsolationGroupResponse struct {
+	GeneralResponse
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestCreateUHostInstanceDisksRequest(t *testing.T) {
+	req := &CreateUHostInstanceRequest{
+		Zone:    "cn-bj2-04",
+		ImageId: "uimage-foo",
+		Disks: []CreateUHostInstanceDisk{
+			CreateUHostInstanceDisk{IsBoot: "True", Type: "CLOUD_SSD", Size: 40},
+			CreateUHostInstanceDisk{IsBoot: "False", Type: "LOCAL_NORMAL", Size: 100},
+		},
+	}
+
+	params, err := BuildParams(req)
+	if err != nil {
+		t.Fatal("Failed to build params: ", err)
+	}
+
+	cases := []struct{ Arg, Expectation string }{
+		{"Action", "CreateUHostInstance"},
+		{"Zone", "cn-bj2-04"},
+		{"Disks.0.IsBoot", "True"},
+		{"Disks.0.Type", "CLOUD_SSD"},
+		{"Disks.0.Size", "40"},
+		{"Disks.1.IsBoot", "False"},
+		{"Disks.1.Type", "LOCAL_NORMAL"},
+		{"Disks.1.Size", "100"},
+	}
+
+	for _, tc := range cases {
+		real := params.Get(tc.Arg)
+		if real != tc.Expectation {
+			t.Errorf("Expect %s to be %s but got: %s", tc.Arg, tc.Expectation, real)
+		}
+	}
+}
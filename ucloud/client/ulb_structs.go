@@ -0,0 +1,305 @@
+package client
+
+type ULBIP struct {
+	OperatorName  string
+	EIP           string
+	EIPId         string
+	BandwidthType int
+	Bandwidth     int
+}
+
+type ULBBackend struct {
+	BackendId     string
+	ResourceType  string
+	ResourceId    string
+	ResourceName  string
+	SubResourceId string
+	PrivateIP     string
+	Port          int
+	Enabled       int
+	Status        int
+	SubnetId      string
+	Weight        int
+}
+
+type ULBPolicyBackend struct {
+	BackendId    string
+	ObjectId     string
+	Port         int
+	PrivateIP    string
+	ResourceName string
+}
+
+// ULBPolicy is a content forwarding rule of a VServer. Type is Domain or Path,
+// Match is the domain or path expression, and a leading ~ makes it a regular
+// expression.
+type ULBPolicy struct {
+	PolicyId       string
+	PolicyType     string
+	Type           string
+	Match          string
+	PolicyPriority int
+	VServerId      string
+	BackendSet     []ULBPolicyBackend
+}
+
+type ULBVServer struct {
+	VServerId       string
+	VServerName     string
+	ListenType      string
+	Protocol        string
+	FrontendPort    int
+	Method          string
+	PersistenceType string
+	PersistenceInfo string
+	ClientTimeout   int
+	MonitorType     string
+	Domain          string
+	Path            string
+	Status          int
+	BackendSet      []ULBBackend
+	PolicySet       []ULBPolicy
+}
+
+type ULB struct {
+	ULBId      string
+	Name       string
+	Tag        string
+	Remark     string
+	ULBType    string
+	PrivateIP  string
+	VPCId      string
+	SubnetId   string
+	CreateTime int
+	ExpireTime int
+	IPSet      []ULBIP
+	VServerSet []ULBVServer
+}
+
+type CreateULBRequest struct {
+	ULBName    string
+	Tag        string
+	Remark     string
+	OuterMode  string
+	InnerMode  string
+	ChargeType string
+	VPCId      string
+	SubnetId   string
+}
+type CreateULBResponse struct {
+	GeneralResponse
+	ULBId string
+}
+
+type DescribeULBRequest struct {
+	ULBId  string
+	VPCId  string
+	Offset int
+	Limit  int
+}
+type DescribeULBResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []ULB
+}
+
+type UpdateULBAttributeRequest struct {
+	ULBId  string
+	Name   string
+	Tag    string
+	Remark string
+}
+type UpdateULBAttributeResponse struct {
+	GeneralResponse
+}
+
+type DeleteULBRequest struct {
+	ULBId string
+}
+type DeleteULBResponse struct {
+	GeneralResponse
+}
+
+type CreateVServerRequest struct {
+	ULBId           string
+	VServerName     string
+	ListenType      string
+	Protocol        string
+	FrontendPort    int
+	Method          string
+	PersistenceType string
+	PersistenceInfo string
+	ClientTimeout   int
+	MonitorType     string
+	Domain          string
+	Path            string
+}
+type CreateVServerResponse struct {
+	GeneralResponse
+	VServerId string
+}
+
+type DescribeVServerRequest struct {
+	ULBId     string
+	VServerId string
+}
+type DescribeVServerResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []ULBVServer
+}
+
+type UpdateVServerAttributeRequest struct {
+	ULBId           string
+	VServerId       string
+	VServerName     string
+	Method          string
+	PersistenceType string
+	PersistenceInfo string
+	ClientTimeout   int
+	MonitorType     string
+	Domain          string
+	Path            string
+}
+type UpdateVServerAttributeResponse struct {
+	GeneralResponse
+}
+
+type DeleteVServerRequest struct {
+	ULBId     string
+	VServerId string
+}
+type DeleteVServerResponse struct {
+	GeneralResponse
+}
+
+type AllocateBackendRequest struct {
+	ULBId        string
+	VServerId    string
+	ResourceType string
+	ResourceId   string
+	Port         int
+	Weight       int
+	// Enabled is "1" or "0", a string so that "0" is not dropped as empty
+	Enabled string
+}
+type AllocateBackendResponse struct {
+	GeneralResponse
+	BackendId string
+}
+
+type UpdateBackendAttributeRequest struct {
+	ULBId     string
+	BackendId string
+	Port      int
+	Weight    int
+	// Enabled is "1" or "0", a string so that "0" is not dropped as empty
+	Enabled string
+}
+type UpdateBackendAttributeResponse struct {
+	GeneralResponse
+}
+
+type ReleaseBackendRequest struct {
+	ULBId     string
+	BackendId string
+}
+type ReleaseBackendResponse struct {
+	GeneralResponse
+}
+
+type SSLBindedTarget struct {
+	VServerId   string
+	VServerName string
+	ULBId       string
+	ULBName     string
+}
+
+type ULBSSL struct {
+	SSLId           string
+	SSLName         string
+	SSLType         string
+	HashValue       string
+	CreateTime      int
+	BindedTargetSet []SSLBindedTarget
+}
+
+type CreateSSLRequest struct {
+	SSLName    string
+	SSLType    string
+	UserCert   string
+	PrivateKey string `Secret:"true"`
+	CaCert     string
+}
+type CreateSSLResponse struct {
+	GeneralResponse
+	SSLId string
+}
+
+type DescribeSSLRequest struct {
+	SSLId  string
+	Offset int
+	Limit  int
+}
+type DescribeSSLResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []ULBSSL
+}
+
+type DeleteSSLRequest struct {
+	SSLId string
+}
+type DeleteSSLResponse struct {
+	GeneralResponse
+}
+
+type BindSSLRequest struct {
+	SSLId     string
+	ULBId     string
+	VServerId string
+}
+type BindSSLResponse struct {
+	GeneralResponse
+}
+
+type UnbindSSLRequest struct {
+	SSLId     string
+	ULBId     string
+	VServerId string
+}
+type UnbindSSLResponse struct {
+	GeneralResponse
+}
+
+type CreatePolicyRequest struct {
+	ULBId     string
+	VServerId string
+	BackendId []string
+	Type      string
+	Match     string
+}
+type CreatePolicyResponse struct {
+	GeneralResponse
+	PolicyId string
+}
+
+type UpdatePolicyRequest struct {
+	ULBId     string
+	VServerId string
+	PolicyId  string
+	BackendId []string
+	Type      string
+	Match     string
+}
+type UpdatePolicyResponse struct {
+	GeneralResponse
+}
+
+type DeletePolicyRequest struct {
+	PolicyId  string
+	VServerId string
+}
+type DeletePolicyResponse struct {
+	GeneralResponse
+}
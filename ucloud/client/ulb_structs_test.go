@@ -0,0 +1,23 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestUpdateBackendAttributeRequestDisabled(t *testing.T) {
+	params, err := BuildParams(&UpdateBackendAttributeRequest{
+		ULBId:     "ulb-foo",
+		BackendId: "backend-bar",
+		Enabled:   "0",
+	})
+	if err != nil {
+		t.Fatal("Failed to build params: ", err)
+	}
+
+	if v, ok := params["Enabled"]; !ok || v[0] != "0" {
+		t.Error("Expect Enabled to be 0 but got: ", v)
+	}
+	if _, ok := params["Port"]; ok {
+		t.Error("Expect empty Port to be omitted")
+	}
+}
@@ -0,0 +1,224 @@
+package client
+
+// URedisGroup is a master-slave redis instance.
+type URedisGroup struct {
+	GroupId          string
+	Name             string
+	Zone             string
+	Version          string
+	HighAvailability string
+	Size             int
+	UsedSize         int
+	VirtualIP        string
+	Port             int
+	State            string
+	ChargeType       string
+	VPCId            string
+	SubnetId         string
+	Tag              string
+	CreateTime       int
+	ModifyTime       int
+	ExpireTime       int
+}
+
+type CreateURedisGroupRequest struct {
+	Zone             string
+	Name             string
+	HighAvailability string
+	Version          string
+	Size             int
+	Password         string `Secret:"true"`
+	ChargeType       string
+	Quantity         int
+	VPCId            string
+	SubnetId         string
+}
+type CreateURedisGroupResponse struct {
+	GeneralResponse
+	GroupId string
+}
+
+type DescribeURedisGroupRequest struct {
+	Zone    string
+	GroupId string
+	Offset  int
+	Limit   int
+}
+type DescribeURedisGroupResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []URedisGroup
+}
+
+type ModifyURedisGroupNameRequest struct {
+	Zone    string
+	GroupId string
+	Name    string
+}
+type ModifyURedisGroupNameResponse struct {
+	GeneralResponse
+}
+
+type ModifyURedisGroupPasswordRequest struct {
+	Zone     string
+	GroupId  string
+	Password string `Secret:"true"`
+}
+type ModifyURedisGroupPasswordResponse struct {
+	GeneralResponse
+}
+
+type ResizeURedisGroupRequest struct {
+	Zone    string
+	GroupId string
+	Size    int
+}
+type ResizeURedisGroupResponse struct {
+	GeneralResponse
+}
+
+type DeleteURedisGroupRequest struct {
+	Zone    string
+	GroupId string
+}
+type DeleteURedisGroupResponse struct {
+	GeneralResponse
+}
+
+type UMemSpaceAddress struct {
+	IP   string
+	Port int
+}
+
+// UMemSpace is a distributed redis instance.
+type UMemSpace struct {
+	SpaceId    string
+	Name       string
+	Zone       string
+	Protocol   string
+	Size       int
+	UsedSize   int
+	Address    []UMemSpaceAddress
+	State      string
+	ChargeType string
+	VPCId      string
+	SubnetId   string
+	CreateTime int
+	ExpireTime int
+}
+
+type CreateUMemSpaceRequest struct {
+	Zone       string
+	Name       string
+	Protocol   string
+	Size       int
+	ChargeType string
+	Quantity   int
+	VPCId      string
+	SubnetId   string
+}
+type CreateUMemSpaceResponse struct {
+	GeneralResponse
+	SpaceId string
+}
+
+type DescribeUMemSpaceRequest struct {
+	Zone    string
+	SpaceId string
+	Offset  int
+	Limit   int
+}
+type DescribeUMemSpaceResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []UMemSpace
+}
+
+type ModifyUMemSpaceNameRequest struct {
+	Zone    string
+	SpaceId string
+	Name    string
+}
+type ModifyUMemSpaceNameResponse struct {
+	GeneralResponse
+}
+
+type ResizeUMemSpaceRequest struct {
+	Zone    string
+	SpaceId string
+	Size    int
+}
+type ResizeUMemSpaceResponse struct {
+	GeneralResponse
+}
+
+type DeleteUMemSpaceRequest struct {
+	Zone    string
+	SpaceId string
+}
+type DeleteUMemSpaceResponse struct {
+	GeneralResponse
+}
+
+type UMemcacheGroup struct {
+	GroupId    string
+	Name       string
+	Zone       string
+	Version    string
+	Size       int
+	UsedSize   int
+	VirtualIP  string
+	Port       int
+	State      string
+	ChargeType string
+	VPCId      string
+	SubnetId   string
+	Tag        string
+	CreateTime int
+	ModifyTime int
+	ExpireTime int
+}
+
+type CreateUMemcacheGroupRequest struct {
+	Zone       string
+	Name       string
+	Version    string
+	Size       int
+	ChargeType string
+	Quantity   int
+	VPCId      string
+	SubnetId   string
+}
+type CreateUMemcacheGroupResponse struct {
+	GeneralResponse
+	GroupId string
+}
+
+type DescribeUMemcacheGroupRequest struct {
+	Zone    string
+	GroupId string
+	Offset  int
+	Limit   int
+}
+type DescribeUMemcacheGroupResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []UMemcacheGroup
+}
+
+type ResizeUMemcacheGroupRequest struct {
+	Zone    string
+	GroupId string
+	Size    int
+}
+type ResizeUMemcacheGroupResponse struct {
+	GeneralResponse
+}
+
+type DeleteUMemcacheGroupRequest struct {
+	Zone    string
+	GroupId string
+}
+type DeleteUMemcacheGroupResponse struct {
+	GeneralResponse
+}
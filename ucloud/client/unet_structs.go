@@ -201,3 +201,117 @@ type DeleteSecurityGroupRequest struct {
 type DeleteSecurityGroupResponse struct {
 	GeneralResponse
 }
+
+type ShareBandwidthEIP struct {
+	EIPId   string
+	EIPAddr []EIPAddr
+}
+
+type ShareBandwidth struct {
+	ShareBandwidthId string
+	ShareBandwidth   int
+	Name             string
+	ChargeType       string
+	CreateTime       int
+	ExpireTime       int
+	EIPSet           []ShareBandwidthEIP
+}
+
+type AllocateShareBandwidthRequest struct {
+	Name           string
+	ChargeType     string
+	ShareBandwidth int
+	Quantity       int
+}
+type AllocateShareBandwidthResponse struct {
+	GeneralResponse
+	ShareBandwidthId string
+}
+
+type DescribeShareBandwidthRequest struct {
+	ShareBandwidthIds []string
+}
+type DescribeShareBandwidthResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []ShareBandwidth
+}
+
+type ResizeShareBandwidthRequest struct {
+	ShareBandwidthId string
+	ShareBandwidth   int
+}
+type ResizeShareBandwidthResponse struct {
+	GeneralResponse
+}
+
+// ReleaseShareBandwidthRequest releases a shared bandwidth, the EIPs still
+// associated fall back to their own bandwidth of EIPBandwidth Mbps.
+type ReleaseShareBandwidthRequest struct {
+	ShareBandwidthId string
+	EIPBandwidth     int
+	PayMode          string
+}
+type ReleaseShareBandwidthResponse struct {
+	GeneralResponse
+}
+
+type AssociateEIPWithShareBandwidthRequest struct {
+	ShareBandwidthId string
+	EIPIds           []string
+}
+type AssociateEIPWithShareBandwidthResponse struct {
+	GeneralResponse
+}
+
+// DisassociateEIPWithShareBandwidthRequest moves EIPs out of a shared
+// bandwidth, giving them their own bandwidth of Bandwidth Mbps.
+type DisassociateEIPWithShareBandwidthRequest struct {
+	ShareBandwidthId string
+	EIPIds           []string
+	Bandwidth        int
+	PayMode          string
+}
+type DisassociateEIPWithShareBandwidthResponse struct {
+	GeneralResponse
+}
+
+type BandwidthPackage struct {
+	BandwidthPackageId string
+	EIPId              string
+	EIPAddr            []EIPAddr
+	Bandwidth          int
+	EnableTime         int
+	DisableTime        int
+	CreateTime         int
+}
+
+// CreateBandwidthPackageRequest raises the bandwidth of an EIP by Bandwidth
+// Mbps for TimeRange hours from EnableTime, which defaults to now.
+type CreateBandwidthPackageRequest struct {
+	EIPId      string
+	Bandwidth  int
+	TimeRange  int
+	EnableTime int
+}
+type CreateBandwidthPackageResponse struct {
+	GeneralResponse
+	BandwidthPackageId string
+}
+
+type DescribeBandwidthPackageRequest struct {
+	Offset int
+	Limit  int
+}
+type DescribeBandwidthPackageResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSets   []BandwidthPackage
+}
+
+type DeleteBandwidthPackageRequest struct {
+	BandwidthPackageId string
+}
+type DeleteBandwidthPackageResponse struct {
+	GeneralResponse
+}
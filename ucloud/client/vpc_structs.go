@@ -0,0 +1,171 @@
+package client
+
+import (
+	"fmt"
+)
+
+type VPC struct {
+	VPCId       string
+	Name        string
+	Tag         string
+	Remark      string
+	Network     []string
+	SubnetCount int
+	CreateTime  int
+	UpdateTime  int
+}
+
+type DescribeVPCRequest struct {
+	VPCIds []string
+	Tag    string
+}
+type DescribeVPCResponse struct {
+	GeneralResponse
+	DataSet []VPC
+}
+
+type VPCIntercom struct {
+	ProjectId string
+	VPCId     string
+	Name      string
+	Tag       string
+	Network   []string
+	DstRegion string
+}
+
+type CreateVPCIntercomRequest struct {
+	VPCId        string
+	DstVPCId     string
+	DstRegion    string
+	DstProjectId string
+}
+type CreateVPCIntercomResponse struct {
+	GeneralResponse
+}
+
+type DescribeVPCIntercomRequest struct {
+	VPCId        string
+	DstRegion    string
+	DstProjectId string
+}
+type DescribeVPCIntercomResponse struct {
+	GeneralResponse
+	DataSet []VPCIntercom
+}
+
+type DeleteVPCIntercomRequest struct {
+	VPCId        string
+	DstVPCId     string
+	DstRegion    string
+	DstProjectId string
+}
+type DeleteVPCIntercomResponse struct {
+	GeneralResponse
+}
+
+type Subnet struct {
+	SubnetId     string
+	SubnetName   string
+	Subnet       string
+	Netmask      string
+	VPCId        string
+	RouteTableId string
+	Tag          string
+	Remark       string
+	CreateTime   int
+}
+
+type DescribeSubnetRequest struct {
+	SubnetIds []string
+	VPCId     string
+	Offset    int
+	Limit     int
+}
+type DescribeSubnetResponse struct {
+	GeneralResponse
+	TotalCount int
+	DataSet    []Subnet
+}
+
+type RouteRule struct {
+	RouteRuleId  string
+	RouteTableId string
+	DstAddr      string
+	NexthopId    string
+	NexthopType  string
+	RuleType     int
+	Remark       string
+}
+
+// RouteRuleAction adds, updates or deletes a rule in ModifyRouteRule. Action
+// is one of add, update and delete, RouteRuleId must be empty on add.
+type RouteRuleAction struct {
+	RouteRuleId string
+	DstAddr     string
+	NexthopType string
+	NexthopId   string
+	Remark      string
+	Action      string
+}
+
+func (rule RouteRuleAction) Parameterize() (string, error) {
+	// the fifth field is priority, which is reserved and must be 0
+	return fmt.Sprintf("%s|%s|%s|%s|0|%s|%s", rule.RouteRuleId, rule.DstAddr, rule.NexthopType, rule.NexthopId, rule.Remark, rule.Action), nil
+}
+
+type RouteTable struct {
+	RouteTableId   string
+	RouteTableType int
+	SubnetCount    int
+	VPCId          string
+	VPCName        string
+	Tag            string
+	Remark         string
+	CreateTime     int
+	RouteRules     []RouteRule
+}
+
+type CreateRouteTableRequest struct {
+	VPCId  string
+	Tag    string
+	Remark string
+}
+type CreateRouteTableResponse struct {
+	GeneralResponse
+	RouteTableId string
+}
+
+type DescribeRouteTableRequest struct {
+	VPCId        string
+	RouteTableId string
+	OffSet       int
+	Limit        int
+}
+type DescribeRouteTableResponse struct {
+	GeneralResponse
+	TotalCount  int
+	RouteTables []RouteTable
+}
+
+type ModifyRouteRuleRequest struct {
+	RouteTableId string
+	RouteRule    []RouteRuleAction
+}
+type ModifyRouteRuleResponse struct {
+	GeneralResponse
+}
+
+type AssociateRouteTableRequest struct {
+	SubnetId     string
+	RouteTableId string
+}
+type AssociateRouteTableResponse struct {
+	GeneralResponse
+}
+
+type DeleteRouteTableRequest struct {
+	RouteTableId string
+}
+type DeleteRouteTableResponse struct {
+	GeneralResponse
+}
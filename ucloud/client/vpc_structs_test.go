@@ -0,0 +1,44 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestModifyRouteRuleRequest(t *testing.T) {
+	req := &ModifyRouteRuleRequest{
+		RouteTableId: "rt-foo",
+		RouteRule: []RouteRuleAction{
+			RouteRuleAction{
+				DstAddr:     "10.10.0.0/16",
+				NexthopType: "instance",
+				NexthopId:   "uhost-foo",
+				Remark:      "firewall",
+				Action:      "add",
+			},
+			RouteRuleAction{
+				RouteRuleId: "route-bar",
+				DstAddr:     "10.20.0.0/16",
+				Action:      "delete",
+			},
+		},
+	}
+
+	params, err := BuildParams(req)
+	if err != nil {
+		t.Fatal("Failed to build params: ", err)
+	}
+
+	cases := []struct{ Arg, Expectation string }{
+		{"Action", "ModifyRouteRule"},
+		{"RouteTableId", "rt-foo"},
+		{"RouteRule.0", "|10.10.0.0/16|instance|uhost-foo|0|firewall|add"},
+		{"RouteRule.1", "route-bar|10.20.0.0/16|||0||delete"},
+	}
+
+	for _, tc := range cases {
+		real := params.Get(tc.Arg)
+		if real != tc.Expectation {
+			t.Errorf("Expect %s to be %s but got: %s", tc.Arg, tc.Expectation, real)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package ucloud
+
+import (
+	"fmt"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceUHost reads a single host by uhost_id or name, with the
+// attributes of ucloud_uhost.
+func dataSourceUHost() *schema.Resource {
+	dataSchema := dataSourceSchemaFromResource(resourceUHost().Schema,
+		"zone",
+		"image_id",
+		"cpu",
+		"memory",
+		"charge_type",
+		"auto_renew",
+		"expire_time",
+		"net_capability",
+		"tag",
+		"uhost_type",
+		"storage_type",
+		"isolation_group",
+		"power_state",
+		"basic_image_id",
+		"basic_image_name",
+		"remark",
+		"boot_disk",
+		"data_disk",
+		"disk_set",
+		"ip_set",
+	)
+	dataSchema["uhost_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	dataSchema["name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"uhost_id"},
+	}
+	dataSchema["zone"].Optional = true
+
+	return &schema.Resource{
+		Read:   dataSourceUHostRead,
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceUHostRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	var instance *client.UHostInstance
+	if v, ok := d.GetOk("uhost_id"); ok {
+		var err error
+		instance, err = describeInstance(apiClient, v.(string))
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("Instance %s not found", v.(string))
+		}
+	} else if v, ok := d.GetOk("name"); ok {
+		instances, err := describeInstancesByName(apiClient, d.Get("zone").(string), v.(string))
+		if err != nil {
+			return err
+		}
+		if len(instances) != 1 {
+			return fmt.Errorf("Expect one instance named %q, found %d", v.(string), len(instances))
+		}
+		instance = &instances[0]
+	} else {
+		return fmt.Errorf("One of uhost_id and name is required")
+	}
+
+	d.SetId(instance.UHostId)
+	d.Set("uhost_id", instance.UHostId)
+	d.Set("zone", instance.Zone)
+	d.Set("image_id", instance.ImageId)
+	setResourceDataFromInstance(d, instance)
+
+	return nil
+}
+
+func describeInstancesByName(apiClient *client.Client, zone, name string) ([]client.UHostInstance, error) {
+	params := client.DescribeUHostInstanceRequest{
+		Zone:  zone,
+		Limit: 100,
+	}
+
+	var instances []client.UHostInstance
+	for {
+		var resp client.DescribeUHostInstanceResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range resp.UHostSet {
+			if instance.Name == name {
+				instances = append(instances, instance)
+			}
+		}
+
+		params.Offset += params.Limit
+		if len(resp.UHostSet) < params.Limit || params.Offset >= resp.TotalCount {
+			return instances, nil
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceUHostInstanceTypes lists the cpu and memory combinations a
+// ucloud_uhost accepts in a zone.
+func dataSourceUHostInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUHostInstanceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"uhost_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list this machine type such as N2",
+			},
+
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only list combinations with this many cores",
+			},
+
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uhost_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"machine_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Memory in MB",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUHostInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	types, err := apiClient.AvailableInstanceTypes(zone)
+	if err != nil {
+		return err
+	}
+
+	instanceTypes := flattenUHostInstanceTypes(types, d.Get("uhost_type").(string))
+	if v, ok := d.GetOk("cpu"); ok {
+		filtered := make([]map[string]interface{}, 0, len(instanceTypes))
+		for _, instanceType := range instanceTypes {
+			if instanceType["cpu"].(int) == v.(int) {
+				filtered = append(filtered, instanceType)
+			}
+		}
+		instanceTypes = filtered
+	}
+
+	d.SetId(buildCompositeId(zone, d.Get("uhost_type").(string), strconv.Itoa(d.Get("cpu").(int))))
+	d.Set("instance_types", instanceTypes)
+
+	return nil
+}
+
+// flattenUHostInstanceTypes lists every machine type, cpu and memory
+// combination of types, only of uhostType unless it is empty.
+func flattenUHostInstanceTypes(types []client.AvailableInstanceType, uhostType string) []map[string]interface{} {
+	var ret []map[string]interface{}
+	for _, instanceType := range types {
+		if uhostType != "" && instanceType.Name != uhostType {
+			continue
+		}
+
+		for _, size := range instanceType.MachineSizes {
+			for _, collection := range size.Collection {
+				for _, memory := range collection.Memory {
+					ret = append(ret, map[string]interface{}{
+						"uhost_type":    instanceType.Name,
+						"machine_class": instanceType.MachineClass,
+						"cpu":           collection.Cpu,
+						"memory":        int(memory * 1024),
+					})
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// validateUHostInstanceType checks cpu and memory in MB against the machine
+// types of zone. uhost_type also takes the legacy host types such as Normal,
+// which are not machine types, so it only narrows the check when it names
+// one of types.
+func validateUHostInstanceType(types []client.AvailableInstanceType, zone, uhostType string, cpu, memory int) error {
+	instanceTypes := flattenUHostInstanceTypes(types, uhostType)
+	if len(instanceTypes) == 0 {
+		instanceTypes = flattenUHostInstanceTypes(types, "")
+		uhostType = ""
+	}
+
+	var allowed []int
+	for _, instanceType := range instanceTypes {
+		if instanceType["cpu"].(int) != cpu {
+			continue
+		}
+		if instanceType["memory"].(int) == memory {
+			return nil
+		}
+		allowed = append(allowed, instanceType["memory"].(int))
+	}
+
+	in := "zone " + zone
+	if uhostType != "" {
+		in = "uhost_type " + uhostType + " of " + in
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("cpu %d is not available in %s", cpu, in)
+	}
+
+	sort.Ints(allowed)
+	allowedStrings := make([]string, 0, len(allowed))
+	for i, m := range allowed {
+		if i == 0 || m != allowed[i-1] {
+			allowedStrings = append(allowedStrings, strconv.Itoa(m))
+		}
+	}
+	return fmt.Errorf("memory %d is not available with cpu %d in %s, expected one of %s", memory, cpu, in, strings.Join(allowedStrings, ", "))
+}
+
+// validateUHostInstanceTypeDiff checks cpu and memory of a ucloud_uhost at
+// plan time, when they are known. An unknown uhost_type, as on new hosts
+// which leave it to the API, is checked against every machine type.
+func validateUHostInstanceTypeDiff(d *schema.ResourceDiff, c *client.Client) error {
+	if d.Id() != "" && !d.HasChange("cpu") && !d.HasChange("memory") && !d.HasChange("uhost_type") {
+		return nil
+	}
+	for _, key := range []string{"zone", "cpu", "memory"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	// left to the defaults of the API
+	cpu, memory := d.Get("cpu").(int), d.Get("memory").(int)
+	if cpu == 0 || memory == 0 {
+		return nil
+	}
+
+	zone := d.Get("zone").(string)
+	types, err := c.AvailableInstanceTypes(zone)
+	if err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		log.Printf("[WARN] No instance types found in zone %s, skipping validation of cpu and memory", zone)
+		return nil
+	}
+
+	uhostType := ""
+	if d.NewValueKnown("uhost_type") {
+		uhostType = d.Get("uhost_type").(string)
+	}
+
+	return validateUHostInstanceType(types, zone, uhostType, cpu, memory)
+}
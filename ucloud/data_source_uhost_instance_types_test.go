@@ -0,0 +1,140 @@
+package ucloud
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceUHostInstanceTypes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostInstanceTypesDataSourceConfig, os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageDataSourceID("data.ucloud_uhost_instance_types.foo"),
+					resource.TestCheckResourceAttrSet("data.ucloud_uhost_instance_types.foo", "instance_types.0.memory"),
+					resource.TestCheckResourceAttr("data.ucloud_uhost_instance_types.foo", "instance_types.0.cpu", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostInstanceTypesDataSourceConfig = `
+data "ucloud_uhost_instance_types" "foo" {
+	zone = "%s"
+	cpu = 2
+}
+`
+
+var testUHostInstanceTypes = []client.AvailableInstanceType{
+	{
+		Name:         "N2",
+		MachineClass: "N",
+		MachineSizes: []client.UHostMachineSize{
+			{Collection: []client.UHostMachineSizeCollection{
+				{Cpu: 1, Memory: []float64{1, 2, 4}},
+				{Cpu: 2, Memory: []float64{2, 4, 8}},
+			}},
+		},
+	},
+	{
+		Name:         "C1",
+		MachineClass: "C",
+		MachineSizes: []client.UHostMachineSize{
+			{Collection: []client.UHostMachineSizeCollection{
+				{Cpu: 2, Memory: []float64{16}},
+			}},
+		},
+	},
+}
+
+func TestFlattenUHostInstanceTypes(t *testing.T) {
+	if n := len(flattenUHostInstanceTypes(testUHostInstanceTypes, "")); n != 7 {
+		t.Errorf("Expect 7 combinations but got %d", n)
+	}
+
+	instanceTypes := flattenUHostInstanceTypes(testUHostInstanceTypes, "C1")
+	if len(instanceTypes) != 1 || instanceTypes[0]["cpu"] != 2 || instanceTypes[0]["memory"] != 16384 || instanceTypes[0]["machine_class"] != "C" {
+		t.Errorf("Unexpected combinations of C1: %v", instanceTypes)
+	}
+}
+
+func TestValidateUHostInstanceType(t *testing.T) {
+	cases := []struct {
+		UHostType   string
+		CPU, Memory int
+		Error       string
+	}{
+		{"N2", 1, 2048, ""},
+		{"", 2, 16384, ""},
+		// legacy host type checked against every machine type
+		{"Normal", 2, 16384, ""},
+		{"N2", 2, 16384, "expected one of 2048, 4096, 8192"},
+		{"C1", 1, 1024, "cpu 1 is not available in uhost_type C1 of zone cn-bj2-02"},
+		{"", 4, 8192, "cpu 4 is not available in zone cn-bj2-02"},
+	}
+
+	for _, tc := range cases {
+		err := validateUHostInstanceType(testUHostInstanceTypes, "cn-bj2-02", tc.UHostType, tc.CPU, tc.Memory)
+		if tc.Error == "" {
+			if err != nil {
+				t.Errorf("Expect %s %d/%d to be valid but got: %s", tc.UHostType, tc.CPU, tc.Memory, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tc.Error) {
+			t.Errorf("Expect %s %d/%d to fail with %q but got: %v", tc.UHostType, tc.CPU, tc.Memory, tc.Error, err)
+		}
+	}
+}
+
+func TestResourceUHostDiffInstanceType(t *testing.T) {
+	hs := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		io.WriteString(rw, `{"RetCode":0,"AvailableInstanceTypes":[`+
+			`{"Name":"N2","Zone":"cn-bj2-02","Status":"Normal","MachineClass":"N","MachineSizes":[{"Collection":[{"Cpu":2,"Memory":[2,4,8]}]}]},`+
+			`{"Name":"C1","Zone":"cn-bj2-02","Status":"Normal","MachineClass":"C","MachineSizes":[{"Collection":[{"Cpu":2,"Memory":[16]}]}]}]}`)
+	}))
+	defer hs.Close()
+
+	c, err := client.Config{
+		Endpoint:   hs.URL,
+		PublicKey:  "ucloudsomeone@example.com1296235120854146120",
+		PrivateKey: "46f09bb9fab4f12dfc160dae12273d5332b5debe",
+		Region:     "cn-bj2",
+	}.Client()
+	if err != nil {
+		t.Fatal("Error creating client ", err)
+	}
+
+	cases := []struct {
+		Config map[string]interface{}
+		Error  string
+	}{
+		// uhost_type is unknown on new hosts without it
+		{map[string]interface{}{"cpu": 2, "memory": 16384}, ""},
+		{map[string]interface{}{"cpu": 2, "memory": 3072}, "expected one of 2048, 4096, 8192, 16384"},
+		{map[string]interface{}{"cpu": 2, "memory": 16384, "uhost_type": "N2"}, "expected one of 2048, 4096, 8192"},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"zone":     "cn-bj2-02",
+			"image_id": "uimage-foo",
+		}
+		for k, v := range tc.Config {
+			raw[k] = v
+		}
+
+		testResourceDiff(t, resourceUHost(), nil, raw, c, tc.Error)
+	}
+}
@@ -0,0 +1,151 @@
+package ucloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceUHostPrice quotes a host sized like ucloud_uhost, prices are in
+// yuan for quantity periods of charge_type.
+func dataSourceUHostPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUHostPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"cpu": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"memory": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"boot_disk": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     uhostPriceDiskResource(),
+			},
+
+			"data_disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     uhostPriceDiskResource(),
+			},
+
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Month",
+				ValidateFunc: validateAllowedStringValue([]string{"Year", "Month", "Dynamic"}),
+			},
+
+			"quantity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+
+			"uhost_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"net_capability": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"original_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func uhostPriceDiskResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(uhostDiskTypes),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceUHostPriceRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	disks, err := expandUHostDisks(d)
+	if err != nil {
+		return err
+	}
+
+	params := client.GetUHostInstancePriceRequest{
+		Zone:          d.Get("zone").(string),
+		ImageId:       d.Get("image_id").(string),
+		CPU:           d.Get("cpu").(int),
+		Memory:        d.Get("memory").(int),
+		Count:         1,
+		ChargeType:    d.Get("charge_type").(string),
+		Quantity:      d.Get("quantity").(int),
+		UHostType:     d.Get("uhost_type").(string),
+		NetCapability: d.Get("net_capability").(string),
+		Disks:         disks,
+	}
+
+	var resp client.GetUHostInstancePriceResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	var price *client.UHostPrice
+	for i := range resp.PriceSet {
+		if resp.PriceSet[i].ChargeType == params.ChargeType {
+			price = &resp.PriceSet[i]
+		}
+	}
+	if price == nil {
+		return fmt.Errorf("No %s price returned for the host", params.ChargeType)
+	}
+
+	// the same sizing always has the same id
+	values, err := client.BuildParams(&params)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(hashcode.String(values.Encode())))
+	d.Set("price", price.Price)
+	d.Set("original_price", price.OriginalPrice)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceUHostPrice(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostPriceDataSourceConfig, os.Getenv("UCLOUD_ZONE"), os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageDataSourceID("data.ucloud_uhost_price.small"),
+					resource.TestCheckResourceAttrSet("data.ucloud_uhost_price.small", "price"),
+					testAccCheckUHostPriceLess("data.ucloud_uhost_price.small", "data.ucloud_uhost_price.large"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUHostPriceLess(a, b string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		prices := make([]float64, 0, 2)
+		for _, n := range []string{a, b} {
+			rs, ok := s.RootModule().Resources[n]
+			if !ok {
+				return fmt.Errorf("Can't find data source: %s", n)
+			}
+			price, err := strconv.ParseFloat(rs.Primary.Attributes["price"], 64)
+			if err != nil {
+				return err
+			}
+			prices = append(prices, price)
+		}
+
+		if prices[0] >= prices[1] {
+			return fmt.Errorf("Expect %s to cost less than %s, got %f and %f", a, b, prices[0], prices[1])
+		}
+		return nil
+	}
+}
+
+const testAccUHostPriceDataSourceConfig = `
+data "ucloud_uhost_price" "small" {
+	zone = "%s"
+	image_id = "uimage-j4fbrn"
+	cpu = 1
+	memory = 1024
+
+	boot_disk {
+		type = "CLOUD_SSD"
+		size = 20
+	}
+}
+
+data "ucloud_uhost_price" "large" {
+	zone = "%s"
+	image_id = "uimage-j4fbrn"
+	cpu = 4
+	memory = 8192
+
+	boot_disk {
+		type = "CLOUD_SSD"
+		size = 20
+	}
+
+	data_disk {
+		type = "CLOUD_SSD"
+		size = 100
+	}
+}
+`
@@ -0,0 +1,96 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccDataSourceUHost(t *testing.T) {
+	var host client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostDataSourceConfig, os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttrPair("data.ucloud_uhost.by_id", "name", "ucloud_uhost.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.ucloud_uhost.by_id", "ip_set.0.ip", "ucloud_uhost.foo", "ip_set.0.ip"),
+					resource.TestCheckResourceAttr("data.ucloud_uhost.by_id", "cpu", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_uhost.by_id", "power_state", "running"),
+					resource.TestCheckResourceAttrPair("data.ucloud_uhost.by_name", "uhost_id", "ucloud_uhost.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.ucloud_uhost_vnc.foo", "id", "ucloud_uhost.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.ucloud_uhost_vnc.foo", "vnc_ip"),
+					resource.TestCheckResourceAttrSet("data.ucloud_uhost_vnc.foo", "vnc_port"),
+					resource.TestCheckResourceAttrSet("data.ucloud_uhost_vnc.foo", "vnc_password"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostDataSourceConfig = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "tf-acc-uhost-data-source"
+	cpu = 1
+	memory = 1024
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Dynamic"
+}
+
+data "ucloud_uhost" "by_id" {
+	uhost_id = "${ucloud_uhost.foo.id}"
+}
+
+data "ucloud_uhost" "by_name" {
+	zone = "${ucloud_uhost.foo.zone}"
+	name = "${ucloud_uhost.foo.name}"
+}
+
+data "ucloud_uhost_vnc" "foo" {
+	uhost_id = "${ucloud_uhost.foo.id}"
+	zone = "${ucloud_uhost.foo.zone}"
+}
+`
+
+func TestDataSourceUHostSchema(t *testing.T) {
+	var check func(prefix string, s map[string]*schema.Schema)
+	check = func(prefix string, s map[string]*schema.Schema) {
+		for key, field := range s {
+			if prefix == "" && (key == "uhost_id" || key == "name" || key == "zone") {
+				continue
+			}
+			if !field.Computed || field.Optional || field.Required || field.ForceNew || field.ValidateFunc != nil {
+				t.Errorf("Expect %s%s to be computed only", prefix, key)
+			}
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				check(prefix+key+".", elem.Schema)
+			}
+		}
+	}
+
+	dataSchema := dataSourceUHost().Schema
+	check("", dataSchema)
+
+	if _, ok := dataSchema["password"]; ok {
+		t.Error("Expect password not to be read by the data source")
+	}
+	if !dataSchema["zone"].Optional || !dataSchema["zone"].Computed {
+		t.Error("Expect zone to be optional and computed")
+	}
+	if _, ok := dataSchema["boot_disk"].Elem.(*schema.Resource).Schema["disk_id"]; !ok {
+		t.Error("Expect boot_disk.disk_id to be copied")
+	}
+}
@@ -0,0 +1,67 @@
+package ucloud
+
+import (
+	"fmt"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceUHostVNC gives console access to a host whose network is
+// broken.
+func dataSourceUHostVNC() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUHostVNCRead,
+
+		Schema: map[string]*schema.Schema{
+			"uhost_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"vnc_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vnc_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"vnc_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceUHostVNCRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	uhostId := d.Get("uhost_id").(string)
+	params := client.GetUHostInstanceVncInfoRequest{
+		UHostId: uhostId,
+		Zone:    d.Get("zone").(string),
+	}
+
+	var resp client.GetUHostInstanceVncInfoResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return fmt.Errorf("Error getting VNC info of instance %s: %s", uhostId, err)
+	}
+
+	d.SetId(uhostId)
+	d.Set("vnc_ip", resp.VncIP)
+	d.Set("vnc_port", resp.VncPort)
+	d.Set("vnc_password", resp.VncPassword)
+
+	return nil
+}
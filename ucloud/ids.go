@@ -0,0 +1,28 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// buildCompositeId joins the ids of a resource which can only be addressed
+// through its parent, such as the peering of two VPCs.
+func buildCompositeId(parts ...string) string {
+	return strings.Join(parts, ":")
+}
+
+// parseCompositeId splits an id built by buildCompositeId. names describe
+// the expected parts and are only used in the error message.
+func parseCompositeId(id string, names ...string) ([]string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != len(names) {
+		return nil, fmt.Errorf("Invalid id %q, expected <%s>", id, strings.Join(names, ">:<"))
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("Invalid id %q, expected <%s>", id, strings.Join(names, ">:<"))
+		}
+	}
+
+	return parts, nil
+}
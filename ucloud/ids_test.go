@@ -0,0 +1,21 @@
+package ucloud
+
+import (
+	"testing"
+)
+
+func TestParseCompositeId(t *testing.T) {
+	parts, err := parseCompositeId(buildCompositeId("uvnet-foo", "uvnet-bar"), "vpc_id", "peer_vpc_id")
+	if err != nil {
+		t.Fatal("Failed to parse id: ", err)
+	}
+	if parts[0] != "uvnet-foo" || parts[1] != "uvnet-bar" {
+		t.Error("Invalid parts: ", parts)
+	}
+
+	for _, id := range []string{"uvnet-foo", "uvnet-foo:", ":uvnet-bar", "a:b:c"} {
+		if _, err := parseCompositeId(id, "vpc_id", "peer_vpc_id"); err == nil {
+			t.Errorf("Expect error parsing %q", id)
+		}
+	}
+}
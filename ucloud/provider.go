@@ -48,15 +48,55 @@ func ProviderWithConfig(c *client.Config) terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_ENDPOINT", ""),
 				Description: "UCloud API Endpoint",
 			},
+			"ufile_endpoint": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_UFILE_ENDPOINT", ""),
+				Description: "UFile Endpoint with %s for the bucket name, defaults to https://%s.<region>.ufileos.com",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ucloud_image": dataSourceImage(),
+			"ucloud_image":                dataSourceImage(),
+			"ucloud_uhost_price":          dataSourceUHostPrice(),
+			"ucloud_uhost_instance_types": dataSourceUHostInstanceTypes(),
+			"ucloud_uhost":                dataSourceUHost(),
+			"ucloud_uhost_vnc":            dataSourceUHostVNC(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"ucloud_uhost":          resourceUHost(),
-			"ucloud_security_group": resourceSecurityGroup(),
+			"ucloud_uhost":                      resourceUHost(),
+			"ucloud_security_group":             resourceSecurityGroup(),
+			"ucloud_vpc_peering":                resourceVPCPeering(),
+			"ucloud_nat_gateway":                resourceNATGateway(),
+			"ucloud_nat_gateway_rule":           resourceNATGatewayRule(),
+			"ucloud_nat_gateway_snat_rule":      resourceNATGatewaySNATRule(),
+			"ucloud_route_table":                resourceRouteTable(),
+			"ucloud_route_rule":                 resourceRouteRule(),
+			"ucloud_route_table_association":    resourceRouteTableAssociation(),
+			"ucloud_network_acl":                resourceNetworkACL(),
+			"ucloud_network_acl_entry":          resourceNetworkACLEntry(),
+			"ucloud_network_acl_association":    resourceNetworkACLAssociation(),
+			"ucloud_lb":                         resourceLB(),
+			"ucloud_lb_listener":                resourceLBListener(),
+			"ucloud_lb_attachment":              resourceLBAttachment(),
+			"ucloud_lb_ssl":                     resourceLBSSL(),
+			"ucloud_lb_ssl_attachment":          resourceLBSSLAttachment(),
+			"ucloud_lb_rule":                    resourceLBRule(),
+			"ucloud_db_instance":                resourceDBInstance(),
+			"ucloud_db_param_group":             resourceDBParamGroup(),
+			"ucloud_db_read_replica":            resourceDBReadReplica(),
+			"ucloud_redis_instance":             resourceRedisInstance(),
+			"ucloud_memcache_instance":          resourceMemcacheInstance(),
+			"ucloud_ufile_bucket":               resourceUFileBucket(),
+			"ucloud_ufile_object":               resourceUFileObject(),
+			"ucloud_dns_zone":                   resourceDNSZone(),
+			"ucloud_dns_record":                 resourceDNSRecord(),
+			"ucloud_share_bandwidth":            resourceShareBandwidth(),
+			"ucloud_share_bandwidth_attachment": resourceShareBandwidthAttachment(),
+			"ucloud_bandwidth_package":          resourceBandwidthPackage(),
+			"ucloud_isolation_group":            resourceIsolationGroup(),
+			"ucloud_uhost_renewal":              resourceUHostRenewal(),
 		},
 
 		ConfigureFunc: providerConfigure(c),
@@ -88,6 +128,9 @@ func providerConfigure(c *client.Config) func(*schema.ResourceData) (interface{}
 		if config.Endpoint == "" {
 			config.Endpoint = d.Get("endpoint").(string)
 		}
+		if config.UFileEndpoint == "" {
+			config.UFileEndpoint = d.Get("ufile_endpoint").(string)
+		}
 
 		return config.Client()
 	}
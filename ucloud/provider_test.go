@@ -3,8 +3,10 @@ package ucloud
 import (
 	"log"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -50,3 +52,34 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("UCLOUD_ZONE is not set")
 	}
 }
+
+// testAccPreCheckEnv checks the extra environment variables an acceptance
+// test depends on, such as ids of existing VPCs.
+func testAccPreCheckEnv(t *testing.T, names ...string) {
+	testAccPreCheck(t)
+
+	for _, name := range names {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s is not set", name)
+		}
+	}
+}
+
+// testResourceDiff plans r with the raw config, as an update of state unless
+// it is nil, and checks the plan fails with an error containing
+// expectedError, or succeeds if it is empty.
+func testResourceDiff(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}, expectedError string) {
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal("Error building config: ", err)
+	}
+
+	_, err = r.Diff(state, terraform.NewResourceConfig(rc), meta)
+	if expectedError == "" {
+		if err != nil {
+			t.Errorf("Expect %v to be valid but got: %s", raw, err)
+		}
+	} else if err == nil || !strings.Contains(err.Error(), expectedError) {
+		t.Errorf("Expect %v to fail with %q but got: %v", raw, expectedError, err)
+	}
+}
@@ -0,0 +1,187 @@
+package ucloud
+
+import (
+	"log"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceBandwidthPackage temporarily raises the bandwidth of an EIP.
+// expire_time is known at plan time when enable_time is set. Once the
+// package lapses, UCloud forgets it, but it is kept in state until removed
+// from the configuration so that it is not bought again.
+func resourceBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceBandwidthPackageCreate,
+		Read:          resourceBandwidthPackageRead,
+		Delete:        resourceBandwidthPackageDelete,
+		CustomizeDiff: resourceBandwidthPackageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"eip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntBetween(1, 800),
+				Description:  "Extra bandwidth in Mbps",
+			},
+
+			"enable_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+				Description:      "Start of the package in RFC 3339 such as 2018-06-01T20:00:00+08:00, defaults to now",
+			},
+
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntBetween(1, 720),
+				Description:  "Duration in hours",
+			},
+
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateBandwidthPackageRequest{
+		EIPId:     d.Get("eip_id").(string),
+		Bandwidth: d.Get("bandwidth").(int),
+		TimeRange: d.Get("duration").(int),
+	}
+	if v, ok := d.GetOk("enable_time"); ok {
+		enableTime, _ := time.Parse(time.RFC3339, v.(string))
+		params.EnableTime = int(enableTime.Unix())
+	}
+
+	var resp client.CreateBandwidthPackageResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.BandwidthPackageId)
+
+	return resourceBandwidthPackageRead(d, meta)
+}
+
+func resourceBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	pkg, err := describeBandwidthPackage(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if pkg == nil {
+		if bandwidthPackageExpired(d) {
+			log.Printf("[DEBUG] Bandwidth package %s expired at %s", d.Id(), d.Get("expire_time").(string))
+			return nil
+		}
+
+		log.Printf("[WARN] Bandwidth package %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("eip_id", pkg.EIPId)
+	d.Set("bandwidth", pkg.Bandwidth)
+	d.Set("enable_time", formatUnixTime(pkg.EnableTime))
+	d.Set("duration", (pkg.DisableTime-pkg.EnableTime)/3600)
+	d.Set("expire_time", formatUnixTime(pkg.DisableTime))
+	d.Set("create_time", pkg.CreateTime)
+
+	return nil
+}
+
+func resourceBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	// nothing to delete once expired
+	if bandwidthPackageExpired(d) {
+		d.SetId("")
+		return nil
+	}
+
+	params := client.DeleteBandwidthPackageRequest{BandwidthPackageId: d.Id()}
+	var resp client.DeleteBandwidthPackageResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceBandwidthPackageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("enable_time") && !d.HasChange("duration") {
+		return nil
+	}
+
+	v, ok := d.GetOk("enable_time")
+	if !ok {
+		return nil
+	}
+	enableTime, err := time.Parse(time.RFC3339, v.(string))
+	if err != nil {
+		return nil
+	}
+
+	expireTime := enableTime.Add(time.Duration(d.Get("duration").(int)) * time.Hour)
+	return d.SetNew("expire_time", expireTime.Format(time.RFC3339))
+}
+
+func bandwidthPackageExpired(d *schema.ResourceData) bool {
+	expireTime, err := time.Parse(time.RFC3339, d.Get("expire_time").(string))
+	return err == nil && time.Now().After(expireTime)
+}
+
+func describeBandwidthPackage(apiClient *client.Client, packageId string) (*client.BandwidthPackage, error) {
+	params := client.DescribeBandwidthPackageRequest{
+		Limit: 100,
+	}
+
+	for {
+		var resp client.DescribeBandwidthPackageResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.DataSets {
+			if resp.DataSets[i].BandwidthPackageId == packageId {
+				return &resp.DataSets[i], nil
+			}
+		}
+
+		params.Offset += params.Limit
+		if len(resp.DataSets) < params.Limit || params.Offset >= resp.TotalCount {
+			return nil, nil
+		}
+	}
+}
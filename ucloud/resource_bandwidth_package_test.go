@@ -0,0 +1,61 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceBandwidthPackage(t *testing.T) {
+	enableTime := time.Now().Add(time.Hour).Truncate(time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_EIP_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBandwidthPackageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccBandwidthPackageConfig, os.Getenv("UCLOUD_EIP_ID"), enableTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_bandwidth_package.foo", "bandwidth", "10"),
+					resource.TestCheckResourceAttr("ucloud_bandwidth_package.foo", "duration", "2"),
+					resource.TestCheckResourceAttr("ucloud_bandwidth_package.foo", "expire_time", enableTime.Add(2*time.Hour).Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+const testAccBandwidthPackageConfig = `
+resource "ucloud_bandwidth_package" "foo" {
+	eip_id = "%s"
+	bandwidth = 10
+	enable_time = "%s"
+	duration = 2
+}
+`
+
+func testAccCheckBandwidthPackageDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_bandwidth_package" {
+			continue
+		}
+
+		pkg, err := describeBandwidthPackage(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pkg != nil {
+			return fmt.Errorf("Found undeleted bandwidth package: %+v", pkg)
+		}
+	}
+
+	return nil
+}
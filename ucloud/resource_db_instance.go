@@ -0,0 +1,534 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDBInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDBInstanceCreate,
+		Read:   resourceDBInstanceRead,
+		Update: resourceDBInstanceUpdate,
+		Delete: resourceDBInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDBInstanceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "DB type id such as mysql-5.6 or mysql-5.7",
+			},
+
+			"instance_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Normal",
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Normal", "HA"}),
+			},
+
+			"param_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"admin_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "root",
+				ForceNew: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3306,
+				ForceNew: true,
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory limit in MB",
+			},
+
+			"disk_space": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk space in GB",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"quantity": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "Periods bought at create, not read back so changes are ignored afterwards",
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"backup_begin_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntBetween(0, 23),
+				Description:  "Hour of the day to start the backup",
+			},
+
+			"backup_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateUDBBackupDate,
+				Description:  "Weekdays to back up from Sunday to Saturday, such as 0111110",
+			},
+
+			"backup_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntBetween(1, 30),
+				Description:  "Number of backups to retain",
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	params := client.CreateUDBInstanceRequest{
+		Zone:          zone,
+		DBTypeId:      d.Get("engine_version").(string),
+		InstanceMode:  d.Get("instance_mode").(string),
+		ParamGroupId:  d.Get("param_group_id").(int),
+		AdminUser:     d.Get("admin_user").(string),
+		AdminPassword: d.Get("password").(string),
+		Port:          d.Get("port").(int),
+		MemoryLimit:   d.Get("memory").(int),
+		DiskSpace:     d.Get("disk_space").(int),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = v.(string)
+	}
+	if v, ok := d.GetOk("charge_type"); ok {
+		params.ChargeType = v.(string)
+	}
+	if v, ok := d.GetOk("quantity"); ok {
+		params.Quantity = v.(int)
+	}
+	if v, ok := d.GetOk("vpc_id"); ok {
+		params.VPCId = v.(string)
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		params.SubnetId = v.(string)
+	}
+
+	var resp client.CreateUDBInstanceResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	id := resp.DBId
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for DB instance (%s) to become running", id)
+
+	stateConf := newStateChangeConf(
+		[]string{"Init", "Starting"},
+		[]string{"Running"},
+		udbInstanceRefreshFunc(apiClient, zone, id),
+		d.Timeout(schema.TimeoutCreate),
+	)
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DB instance (%s) to become ready: %s", id, err)
+	}
+
+	if hasUDBBackupStrategy(d) {
+		err = updateUDBBackupStrategy(d, apiClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDBInstanceRead(d, meta)
+}
+
+func resourceDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	instance, err := describeUDBInstance(apiClient, d.Get("zone").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil || instance.State == "Fail" || instance.State == "Delete" {
+		log.Printf("[WARN] DB instance %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("zone", instance.Zone)
+	d.Set("engine_version", instance.DBTypeId)
+	d.Set("instance_mode", instance.InstanceMode)
+	d.Set("param_group_id", instance.ParamGroupId)
+	d.Set("admin_user", instance.AdminUser)
+	d.Set("port", instance.Port)
+	d.Set("memory", instance.MemoryLimit)
+	d.Set("disk_space", instance.DiskSpace)
+	d.Set("name", instance.Name)
+	d.Set("charge_type", instance.ChargeType)
+	d.Set("vpc_id", instance.VPCId)
+	d.Set("subnet_id", instance.SubnetId)
+	d.Set("backup_begin_time", instance.BackupBeginTime)
+	d.Set("backup_date", instance.BackupDate)
+	d.Set("backup_count", instance.BackupCount)
+	d.Set("private_ip", instance.VirtualIP)
+	d.Set("state", instance.State)
+	d.Set("create_time", instance.CreateTime)
+	d.Set("expire_time", instance.ExpiredTime)
+
+	return nil
+}
+
+// resourceDBInstanceImport accepts <zone>:<db_id>, or the plain id of an
+// instance which is then looked up in every zone of the region.
+func resourceDBInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	apiClient := meta.(*client.Client)
+
+	zone, id := "", d.Id()
+	if parts, err := parseCompositeId(d.Id(), "zone", "db_id"); err == nil {
+		zone, id = parts[0], parts[1]
+	}
+
+	instance, err := describeUDBInstance(apiClient, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("DB instance %s not found", d.Id())
+	}
+
+	d.SetId(id)
+	d.Set("zone", instance.Zone)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+
+	d.Partial(true)
+	var resp client.GeneralResponse
+
+	// name
+	if d.HasChange("name") {
+		params := client.ModifyUDBInstanceNameRequest{
+			Zone: zone,
+			DBId: d.Id(),
+			Name: d.Get("name").(string),
+		}
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	// password
+	if d.HasChange("password") {
+		params := client.ModifyUDBInstancePasswordRequest{
+			Zone:     zone,
+			DBId:     d.Id(),
+			Password: d.Get("password").(string),
+		}
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("password")
+	}
+
+	// backup
+	if d.HasChange("backup_begin_time") || d.HasChange("backup_date") || d.HasChange("backup_count") {
+		err := updateUDBBackupStrategy(d, apiClient)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("backup_begin_time")
+		d.SetPartial("backup_date")
+		d.SetPartial("backup_count")
+	}
+
+	// resize: has to restart the instance
+	if d.HasChange("memory") || d.HasChange("disk_space") {
+		err := resizeUDBInstance(apiClient, zone, d.Id(), d.Get("memory").(int), d.Get("disk_space").(int), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("memory")
+		d.SetPartial("disk_space")
+	}
+
+	d.Partial(false)
+
+	return resourceDBInstanceRead(d, meta)
+}
+
+func resourceDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	err := deleteUDBInstance(apiClient, d.Get("zone").(string), d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeUDBInstance(apiClient *client.Client, zone, dbId string) (*client.UDBInstance, error) {
+	params := client.DescribeUDBInstanceRequest{
+		Zone:      zone,
+		ClassType: "SQL",
+		DBId:      dbId,
+	}
+
+	var resp client.DescribeUDBInstanceResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].DBId == dbId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func udbInstanceRefreshFunc(apiClient *client.Client, zone, dbId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := describeUDBInstance(apiClient, zone, dbId)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance == nil {
+			return nil, "", fmt.Errorf("DB instance not found")
+		}
+
+		return instance, instance.State, nil
+	}
+}
+
+func stopUDBInstance(c *client.Client, zone, id string, timeout time.Duration) error {
+	var resp client.GeneralResponse
+	err := c.Call(&client.StopUDBInstanceRequest{Zone: zone, DBId: id}, &resp)
+	if err != nil {
+		return err
+	}
+
+	stateConf := newStateChangeConf(
+		[]string{"Running", "Shutdown"},
+		[]string{"Shutoff"},
+		udbInstanceRefreshFunc(c, zone, id),
+		timeout,
+	)
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func startUDBInstance(c *client.Client, zone, id string, timeout time.Duration) error {
+	var resp client.GeneralResponse
+	err := c.Call(&client.StartUDBInstanceRequest{Zone: zone, DBId: id}, &resp)
+	if err != nil {
+		return err
+	}
+
+	stateConf := newStateChangeConf(
+		[]string{"Shutoff", "Starting"},
+		[]string{"Running"},
+		udbInstanceRefreshFunc(c, zone, id),
+		timeout,
+	)
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// resizeUDBInstance stops the instance, resizes it and starts it again, as
+// UDB only accepts resizing a stopped instance.
+func resizeUDBInstance(c *client.Client, zone, id string, memory, diskSpace int, timeout time.Duration) error {
+	err := stopUDBInstance(c, zone, id, timeout)
+	if err != nil {
+		return err
+	}
+
+	var resp client.GeneralResponse
+	params := client.ResizeUDBInstanceRequest{
+		Zone:        zone,
+		DBId:        id,
+		MemoryLimit: memory,
+		DiskSpace:   diskSpace,
+	}
+	err = c.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	stateConf := newStateChangeConf(
+		[]string{"Upgrading"},
+		[]string{"Shutoff"},
+		udbInstanceRefreshFunc(c, zone, id),
+		timeout,
+	)
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DB instance (%s) to be resized: %s", id, err)
+	}
+
+	return startUDBInstance(c, zone, id, timeout)
+}
+
+func deleteUDBInstance(c *client.Client, zone, id string, timeout time.Duration) error {
+	instance, err := describeUDBInstance(c, zone, id)
+	if err != nil {
+		return err
+	}
+	// not found already gone
+	if instance == nil {
+		return nil
+	}
+
+	if instance.State != "Shutoff" && instance.State != "Fail" {
+		err = stopUDBInstance(c, zone, id, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	var resp client.GeneralResponse
+	return c.Call(&client.DeleteUDBInstanceRequest{Zone: zone, DBId: id}, &resp)
+}
+
+func hasUDBBackupStrategy(d *schema.ResourceData) bool {
+	// backup_begin_time may be set to midnight, the zero value
+	for _, k := range []string{"backup_begin_time", "backup_date", "backup_count"} {
+		if _, ok := d.GetOkExists(k); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func updateUDBBackupStrategy(d *schema.ResourceData, c *client.Client) error {
+	params := client.UpdateUDBInstanceBackupStrategyRequest{
+		Zone:        d.Get("zone").(string),
+		DBId:        d.Id(),
+		BackupDate:  d.Get("backup_date").(string),
+		BackupCount: d.Get("backup_count").(int),
+	}
+	// unset on create, where sending 0 would move the backup to midnight
+	if v, ok := d.GetOkExists("backup_begin_time"); ok {
+		params.BackupTime = strconv.Itoa(v.(int))
+	}
+
+	var resp client.UpdateUDBInstanceBackupStrategyResponse
+	return c.Call(&params, &resp)
+}
+
+var udbBackupDateRegexp = regexp.MustCompile(`^[01]{7}$`)
+
+func validateUDBBackupDate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !udbBackupDateRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must be 7 digits of 0 or 1 from Sunday to Saturday, got %q", k, value))
+	}
+
+	return
+}
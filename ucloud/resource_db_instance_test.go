@@ -0,0 +1,128 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceDBInstance(t *testing.T) {
+	var instance client.UDBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_DB_PARAM_GROUP_ID") },
+		IDRefreshName: "ucloud_db_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstanceConfig("foo", 1000, 20, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists("ucloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "engine_version", "mysql-5.7"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "memory", "1000"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "disk_space", "20"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "state", "Running"),
+					resource.TestCheckResourceAttrSet("ucloud_db_instance.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDBInstanceConfig("foox", 2000, 30, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists("ucloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "name", "foox"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "memory", "2000"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "disk_space", "30"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "state", "Running"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDBInstanceConfig("foox", 2000, 30, testAccDBInstanceBackupConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "backup_begin_time", "0"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "backup_date", "0111110"),
+					resource.TestCheckResourceAttr("ucloud_db_instance.foo", "backup_count", "7"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ucloud_db_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "quantity"},
+			},
+		},
+	})
+}
+
+// testAccDBInstanceConfig returns a DB instance named foo with extra
+// arguments appended to its body.
+func testAccDBInstanceConfig(name string, memory, diskSpace int, extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_db_instance" "foo" {
+	zone = "%s"
+	name = "%s"
+	engine_version = "mysql-5.7"
+	param_group_id = %s
+	password = "Terraform-2018"
+	memory = %d
+	disk_space = %d
+	%s
+}
+`, os.Getenv("UCLOUD_ZONE"), name, os.Getenv("UCLOUD_DB_PARAM_GROUP_ID"), memory, diskSpace, extra)
+}
+
+const testAccDBInstanceBackupConfig = `
+	backup_begin_time = 0
+	backup_date = "0111110"
+	backup_count = 7
+`
+
+func testAccCheckDBInstanceDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_db_instance" {
+			continue
+		}
+
+		instance, err := describeUDBInstance(apiClient, rs.Primary.Attributes["zone"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
+			return fmt.Errorf("Found undeleted DB instance: %+v", instance)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDBInstanceExists(n string, i *client.UDBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		instance, err := describeUDBInstance(apiClient, rs.Primary.Attributes["zone"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("DB instance not found")
+		}
+
+		*i = *instance
+		return nil
+	}
+}
@@ -0,0 +1,255 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDBParamGroup manages a UDB parameter group copied from
+// src_group_id. Only the parameters listed in parameters are managed, the
+// rest keep the values of the source group, and so does a parameter once it
+// is removed from the configuration.
+func resourceDBParamGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDBParamGroupCreate,
+		Read:   resourceDBParamGroupRead,
+		Update: resourceDBParamGroupUpdate,
+		Delete: resourceDBParamGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"src_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDBParamGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	params := client.CreateUDBParamGroupRequest{
+		Zone:        zone,
+		GroupName:   d.Get("name").(string),
+		SrcGroupId:  d.Get("src_group_id").(int),
+		DBTypeId:    d.Get("engine_version").(string),
+		Description: d.Get("description").(string),
+	}
+
+	var resp client.CreateUDBParamGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(resp.GroupId))
+
+	changed, _ := diffDBParameters(nil, d.Get("parameters").(map[string]interface{}))
+	err = updateDBParameters(apiClient, zone, resp.GroupId, changed)
+	if err != nil {
+		return err
+	}
+
+	return resourceDBParamGroupRead(d, meta)
+}
+
+func resourceDBParamGroupRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid DB parameter group id %q", d.Id())
+	}
+
+	group, err := describeUDBParamGroup(apiClient, d.Get("zone").(string), groupId)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		log.Printf("[WARN] DB parameter group %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// only read back the managed parameters
+	managed := d.Get("parameters").(map[string]interface{})
+	parameters := make(map[string]interface{}, len(managed))
+	for _, member := range group.ParamMember {
+		if _, ok := managed[member.Key]; ok {
+			parameters[member.Key] = member.Value
+		}
+	}
+
+	d.Set("name", group.GroupName)
+	d.Set("engine_version", group.DBTypeId)
+	d.Set("description", group.Description)
+	d.Set("parameters", parameters)
+
+	return nil
+}
+
+func resourceDBParamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("parameters") {
+		zone := d.Get("zone").(string)
+		groupId, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Invalid DB parameter group id %q", d.Id())
+		}
+
+		o, n := d.GetChange("parameters")
+		changed, removed := diffDBParameters(o.(map[string]interface{}), n.(map[string]interface{}))
+
+		// removed parameters are reset to the values of the source group
+		if len(removed) > 0 {
+			src, err := describeUDBParamGroup(apiClient, zone, d.Get("src_group_id").(int))
+			if err != nil {
+				return err
+			}
+			if src == nil {
+				return fmt.Errorf("Error resetting parameters %v: source DB parameter group %d not found", removed, d.Get("src_group_id").(int))
+			}
+			for _, key := range removed {
+				for _, member := range src.ParamMember {
+					if member.Key == key {
+						changed[key] = member.Value
+					}
+				}
+			}
+		}
+
+		err = updateDBParameters(apiClient, zone, groupId, changed)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDBParamGroupRead(d, meta)
+}
+
+func resourceDBParamGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid DB parameter group id %q", d.Id())
+	}
+
+	params := client.DeleteUDBParamGroupRequest{
+		Zone:    d.Get("zone").(string),
+		GroupId: groupId,
+	}
+	var resp client.DeleteUDBParamGroupResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// diffDBParameters returns the parameters which are added or changed from o
+// to n, and the keys which are removed.
+func diffDBParameters(o, n map[string]interface{}) (map[string]string, []string) {
+	changed := make(map[string]string)
+	for k, v := range n {
+		if old, ok := o[k]; !ok || old.(string) != v.(string) {
+			changed[k] = v.(string)
+		}
+	}
+
+	var removed []string
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+
+	return changed, removed
+}
+
+func updateDBParameters(c *client.Client, zone string, groupId int, parameters map[string]string) error {
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		params := client.UpdateUDBParamGroupRequest{
+			Zone:    zone,
+			GroupId: groupId,
+			Key:     key,
+			Value:   parameters[key],
+		}
+		var resp client.UpdateUDBParamGroupResponse
+		err := c.Call(&params, &resp)
+		if err != nil {
+			return fmt.Errorf("Error setting DB parameter %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+func describeUDBParamGroup(apiClient *client.Client, zone string, groupId int) (*client.UDBParamGroup, error) {
+	params := client.DescribeUDBParamGroupRequest{
+		Zone:    zone,
+		GroupId: groupId,
+	}
+
+	var resp client.DescribeUDBParamGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].GroupId == groupId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceDBParamGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_DB_PARAM_GROUP_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBParamGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBParamGroupConfig(`
+		max_connections = "3000"
+		wait_timeout = "600"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_db_param_group.foo", "parameters.%", "2"),
+					resource.TestCheckResourceAttr("ucloud_db_param_group.foo", "parameters.max_connections", "3000"),
+					resource.TestCheckResourceAttr("ucloud_db_param_group.foo", "parameters.wait_timeout", "600"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDBParamGroupConfig(`
+		max_connections = "2000"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_db_param_group.foo", "parameters.%", "1"),
+					resource.TestCheckResourceAttr("ucloud_db_param_group.foo", "parameters.max_connections", "2000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBParamGroupConfig(parameters string) string {
+	return fmt.Sprintf(`
+resource "ucloud_db_param_group" "foo" {
+	zone = "%s"
+	name = "foo"
+	engine_version = "mysql-5.7"
+	src_group_id = %s
+	description = "test"
+	parameters {%s	}
+}
+`, os.Getenv("UCLOUD_ZONE"), os.Getenv("UCLOUD_DB_PARAM_GROUP_ID"), parameters)
+}
+
+func testAccCheckDBParamGroupDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_db_param_group" {
+			continue
+		}
+
+		groupId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := describeUDBParamGroup(apiClient, rs.Primary.Attributes["zone"], groupId)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			return fmt.Errorf("Found undeleted DB parameter group: %+v", group)
+		}
+	}
+
+	return nil
+}
+
+func TestDiffDBParameters(t *testing.T) {
+	o := map[string]interface{}{
+		"max_connections": "3000",
+		"wait_timeout":    "600",
+		"sql_mode":        "STRICT_TRANS_TABLES",
+	}
+	n := map[string]interface{}{
+		"max_connections":    "3000",
+		"wait_timeout":       "300",
+		"slow_query_log":     "ON",
+		"innodb_buffer_pool": "1G",
+	}
+
+	changed, removed := diffDBParameters(o, n)
+
+	expected := map[string]string{
+		"wait_timeout":       "300",
+		"slow_query_log":     "ON",
+		"innodb_buffer_pool": "1G",
+	}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Fatalf("expected changed %v, got %v", expected, changed)
+	}
+	if !reflect.DeepEqual(removed, []string{"sql_mode"}) {
+		t.Fatalf("expected removed [sql_mode], got %v", removed)
+	}
+
+	changed, removed = diffDBParameters(nil, n)
+	if len(changed) != len(n) || len(removed) != 0 {
+		t.Fatalf("expected all parameters to be added, got %v and %v", changed, removed)
+	}
+}
@@ -0,0 +1,222 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDBReadReplica manages a slave of a ucloud_db_instance. The engine
+// version and parameter group are inherited from the master.
+func resourceDBReadReplica() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDBReadReplicaCreate,
+		Read:   resourceDBReadReplicaRead,
+		Update: resourceDBReadReplicaUpdate,
+		Delete: resourceDBReadReplicaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"master_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3306,
+				ForceNew: true,
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Memory limit in MB, defaults to the one of the master",
+			},
+
+			"disk_space": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Disk space in GB, defaults to the one of the master",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"param_group_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDBReadReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	params := client.CreateUDBSlaveRequest{
+		Zone:  zone,
+		SrcId: d.Get("master_id").(string),
+		Port:  d.Get("port").(int),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = v.(string)
+	}
+	if v, ok := d.GetOk("memory"); ok {
+		params.MemoryLimit = v.(int)
+	}
+	if v, ok := d.GetOk("disk_space"); ok {
+		params.DiskSpace = v.(int)
+	}
+
+	var resp client.CreateUDBSlaveResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	id := resp.DBId
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for DB read replica (%s) to catch up with %s", id, params.SrcId)
+
+	// the slave stays in Recovering until it has restored the dump of the
+	// master and replication is running
+	stateConf := newStateChangeConf(
+		[]string{"Init", "Starting", "Recovering"},
+		[]string{"Running"},
+		udbInstanceRefreshFunc(apiClient, zone, id),
+		d.Timeout(schema.TimeoutCreate),
+	)
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DB read replica (%s) to become ready: %s", id, err)
+	}
+
+	return resourceDBReadReplicaRead(d, meta)
+}
+
+func resourceDBReadReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	instance, err := describeUDBInstance(apiClient, d.Get("zone").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil || instance.State == "Fail" || instance.State == "Delete" {
+		log.Printf("[WARN] DB read replica %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("zone", instance.Zone)
+	d.Set("master_id", instance.SrcDBId)
+	d.Set("port", instance.Port)
+	d.Set("memory", instance.MemoryLimit)
+	d.Set("disk_space", instance.DiskSpace)
+	d.Set("name", instance.Name)
+	d.Set("engine_version", instance.DBTypeId)
+	d.Set("param_group_id", instance.ParamGroupId)
+	d.Set("private_ip", instance.VirtualIP)
+	d.Set("state", instance.State)
+	d.Set("create_time", instance.CreateTime)
+
+	return nil
+}
+
+func resourceDBReadReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+
+	d.Partial(true)
+
+	// name
+	if d.HasChange("name") {
+		params := client.ModifyUDBInstanceNameRequest{
+			Zone: zone,
+			DBId: d.Id(),
+			Name: d.Get("name").(string),
+		}
+		var resp client.ModifyUDBInstanceNameResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	// resize: has to restart the replica
+	if d.HasChange("memory") || d.HasChange("disk_space") {
+		err := resizeUDBInstance(apiClient, zone, d.Id(), d.Get("memory").(int), d.Get("disk_space").(int), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("memory")
+		d.SetPartial("disk_space")
+	}
+
+	d.Partial(false)
+
+	return resourceDBReadReplicaRead(d, meta)
+}
+
+func resourceDBReadReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	err := deleteUDBInstance(apiClient, d.Get("zone").(string), d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceDBReadReplica(t *testing.T) {
+	var instance client.UDBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_DB_PARAM_GROUP_ID") },
+		IDRefreshName: "ucloud_db_read_replica.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDBReadReplicaDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBReadReplicaConfig("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists("ucloud_db_read_replica.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_db_read_replica.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_db_read_replica.foo", "state", "Running"),
+					resource.TestCheckResourceAttr("ucloud_db_read_replica.foo", "engine_version", "mysql-5.7"),
+					resource.TestCheckResourceAttrPair("ucloud_db_read_replica.foo", "master_id", "ucloud_db_instance.foo", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDBReadReplicaConfig("foox"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists("ucloud_db_read_replica.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_db_read_replica.foo", "name", "foox"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBReadReplicaConfig(name string) string {
+	return testAccDBInstanceConfig("foo", 1000, 20, "") + fmt.Sprintf(`
+resource "ucloud_db_read_replica" "foo" {
+	zone = "${ucloud_db_instance.foo.zone}"
+	master_id = "${ucloud_db_instance.foo.id}"
+	name = "%s"
+}
+`, name)
+}
+
+func testAccCheckDBReadReplicaDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_db_read_replica" && rs.Type != "ucloud_db_instance" {
+			continue
+		}
+
+		instance, err := describeUDBInstance(apiClient, rs.Primary.Attributes["zone"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
+			return fmt.Errorf("Found undeleted DB instance: %+v", instance)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDNSRecord manages a record of a ucloud_dns_zone. All values of a
+// record are answered together, an MX value carries its preference such as
+// "10 mail.example.internal".
+func resourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSRecordCreate,
+		Read:   resourceDNSRecordRead,
+		Update: resourceDNSRecordUpdate,
+		Delete: resourceDNSRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSRecordImport,
+		},
+
+		CustomizeDiff: resourceDNSRecordCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name relative to the zone such as www, or @ for the zone itself",
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"A", "CNAME", "TXT", "MX"}),
+			},
+
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validateIntBetween(5, 86400),
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	recordType := d.Get("type").(string)
+	values := expandStringSet(d.Get("values").(*schema.Set))
+	err := validateDNSRecordValues(recordType, values)
+	if err != nil {
+		return err
+	}
+
+	params := client.CreateUDNSRecordRequest{
+		DNSZoneId: d.Get("zone_id").(string),
+		Name:      d.Get("name").(string),
+		Type:      recordType,
+		Value:     client.UDNSRecordValues(values),
+		ValueType: dnsRecordValueType(values),
+		TTL:       d.Get("ttl").(int),
+		Remark:    d.Get("remark").(string),
+	}
+
+	var resp client.CreateUDNSRecordResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.DNSRecordId)
+
+	return resourceDNSRecordRead(d, meta)
+}
+
+func resourceDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	record, err := describeDNSRecord(apiClient, d.Get("zone_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		log.Printf("[WARN] DNS record %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	values := make([]string, 0, len(record.ValueSet))
+	for _, value := range record.ValueSet {
+		values = append(values, value.Data)
+	}
+
+	d.Set("name", record.Name)
+	d.Set("type", record.Type)
+	d.Set("values", values)
+	d.Set("ttl", record.TTL)
+	d.Set("remark", record.Remark)
+
+	return nil
+}
+
+func resourceDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	values := expandStringSet(d.Get("values").(*schema.Set))
+	err := validateDNSRecordValues(d.Get("type").(string), values)
+	if err != nil {
+		return err
+	}
+
+	params := client.ModifyUDNSRecordRequest{
+		DNSZoneId: d.Get("zone_id").(string),
+		RecordId:  d.Id(),
+		Value:     client.UDNSRecordValues(values),
+		ValueType: dnsRecordValueType(values),
+		TTL:       d.Get("ttl").(int),
+		Remark:    d.Get("remark").(string),
+	}
+	var resp client.ModifyUDNSRecordResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceDNSRecordRead(d, meta)
+}
+
+func resourceDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteUDNSRecordsRequest{
+		DNSZoneId: d.Get("zone_id").(string),
+		RecordIds: []string{d.Id()},
+	}
+	var resp client.DeleteUDNSRecordsResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceDNSRecordImport accepts <zone_id>:<record_id>.
+func resourceDNSRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseCompositeId(d.Id(), "zone_id", "record_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("zone_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeDNSRecord(apiClient *client.Client, zoneId, recordId string) (*client.UDNSRecord, error) {
+	params := client.DescribeUDNSRecordRequest{
+		DNSZoneId: zoneId,
+		RecordIds: []string{recordId},
+	}
+
+	var resp client.DescribeUDNSRecordResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.RecordInfos {
+		if resp.RecordInfos[i].RecordId == recordId {
+			return &resp.RecordInfos[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dnsRecordValueType(values []string) string {
+	if len(values) > 1 {
+		return "Multivalue"
+	}
+
+	return "Normal"
+}
+
+var (
+	dnsRecordDomainRegexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.?$`)
+	dnsRecordMXRegexp     = regexp.MustCompile(`^([0-9]+) (\S+)$`)
+)
+
+// resourceDNSRecordCustomizeDiff validates the values at plan time when they
+// are known, Create and Update check them again otherwise.
+func resourceDNSRecordCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("values") {
+		return nil
+	}
+
+	return validateDNSRecordValues(d.Get("type").(string), expandStringSet(d.Get("values").(*schema.Set)))
+}
+
+// validateDNSRecordValues checks values against the record type, which is
+// not known to the ValidateFunc of values.
+func validateDNSRecordValues(recordType string, values []string) error {
+	if recordType == "CNAME" && len(values) > 1 {
+		return fmt.Errorf("A CNAME record can only have one value, got %d", len(values))
+	}
+
+	for _, value := range values {
+		// the API joins values with | and ,
+		if strings.ContainsAny(value, "|,") {
+			return fmt.Errorf("%s record value %q must not contain | or ,", recordType, value)
+		}
+
+		switch recordType {
+		case "A":
+			if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("A record value must be an IPv4 address, got %q", value)
+			}
+		case "CNAME":
+			if !dnsRecordDomainRegexp.MatchString(value) {
+				return fmt.Errorf("CNAME record value must be a domain, got %q", value)
+			}
+		case "MX":
+			m := dnsRecordMXRegexp.FindStringSubmatch(value)
+			if m == nil || !dnsRecordDomainRegexp.MatchString(m[2]) {
+				return fmt.Errorf("MX record value must be a preference followed by a domain such as \"10 mail.example.com\", got %q", value)
+			}
+		case "TXT":
+			if value == "" || len(value) > 255 {
+				return fmt.Errorf("TXT record value must be 1 to 255 characters, got %d", len(value))
+			}
+		}
+	}
+
+	return nil
+}
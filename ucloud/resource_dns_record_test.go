@@ -0,0 +1,98 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceDNSRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_PEER_VPC_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSRecordConfig(`"10.9.0.1"`, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_dns_record.foo", "values.#", "1"),
+					resource.TestCheckResourceAttr("ucloud_dns_record.foo", "ttl", "60"),
+					resource.TestCheckResourceAttr("ucloud_dns_record.mx", "values.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSRecordConfig(`"10.9.0.1", "10.9.0.2"`, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_dns_record.foo", "values.#", "2"),
+					resource.TestCheckResourceAttr("ucloud_dns_record.foo", "ttl", "300"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ucloud_dns_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDNSRecordImportStateId("ucloud_dns_record.foo"),
+			},
+		},
+	})
+}
+
+func testAccDNSRecordConfig(values string, ttl int) string {
+	return testAccDNSZoneConfig(`vpc_ids = ["%[1]s"]`, "") + fmt.Sprintf(`
+resource "ucloud_dns_record" "foo" {
+	zone_id = "${ucloud_dns_zone.foo.id}"
+	name = "www"
+	type = "A"
+	values = [%s]
+	ttl = %d
+}
+
+resource "ucloud_dns_record" "mx" {
+	zone_id = "${ucloud_dns_zone.foo.id}"
+	name = "@"
+	type = "MX"
+	values = ["10 mail.tf-test.internal"]
+}
+`, values, ttl)
+}
+
+func testAccDNSRecordImportStateId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return buildCompositeId(rs.Primary.Attributes["zone_id"], rs.Primary.ID), nil
+	}
+}
+
+func TestValidateDNSRecordValues(t *testing.T) {
+	cases := []struct {
+		Type   string
+		Values []string
+		Valid  bool
+	}{
+		{"A", []string{"10.9.0.1", "10.9.0.2"}, true},
+		{"A", []string{"10.9.0.256"}, false},
+		{"A", []string{"fd00::1"}, false},
+		{"CNAME", []string{"api.example.internal"}, true},
+		{"CNAME", []string{"api.example.internal."}, true},
+		{"CNAME", []string{"a.example.internal", "b.example.internal"}, false},
+		{"CNAME", []string{"10.9.0.1 foo"}, false},
+		{"MX", []string{"10 mail.example.internal"}, true},
+		{"MX", []string{"mail.example.internal"}, false},
+		{"TXT", []string{"v=spf1 -all"}, true},
+		{"TXT", []string{"a,b"}, false},
+		{"TXT", []string{""}, false},
+	}
+
+	for _, tc := range cases {
+		err := validateDNSRecordValues(tc.Type, tc.Values)
+		if valid := err == nil; valid != tc.Valid {
+			t.Errorf("Expect validity of %s %v to be %t but got: %v", tc.Type, tc.Values, tc.Valid, err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDNSZone manages a private UDNS zone, which is only resolved inside
+// the VPCs in vpc_ids.
+func resourceDNSZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSZoneCreate,
+		Read:   resourceDNSZoneRead,
+		Update: resourceDNSZoneUpdate,
+		Delete: resourceDNSZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Domain of the zone such as example.internal",
+			},
+
+			"recursion_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether names missing in the zone are resolved by the public DNS",
+			},
+
+			"vpc_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateUDNSZoneRequest{
+		DNSZoneName:        d.Get("name").(string),
+		Type:               "private",
+		IsRecursionEnabled: "disable",
+		Remark:             d.Get("remark").(string),
+		Tag:                d.Get("tag").(string),
+	}
+	if d.Get("recursion_enabled").(bool) {
+		params.IsRecursionEnabled = "enable"
+	}
+
+	var resp client.CreateUDNSZoneResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.DNSZoneId)
+
+	for _, vpcId := range expandStringSet(d.Get("vpc_ids").(*schema.Set)) {
+		err = bindDNSZoneVPC(apiClient, d.Id(), vpcId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDNSZoneRead(d, meta)
+}
+
+func resourceDNSZoneRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone, err := describeDNSZone(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if zone == nil {
+		log.Printf("[WARN] DNS zone %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	vpcIds := make([]string, 0, len(zone.VPCInfos))
+	for _, vpc := range zone.VPCInfos {
+		vpcIds = append(vpcIds, vpc.VPCId)
+	}
+
+	d.Set("name", zone.DNSZoneName)
+	d.Set("recursion_enabled", zone.IsRecursionEnabled == "enable")
+	d.Set("vpc_ids", vpcIds)
+	d.Set("tag", zone.Tag)
+	d.Set("remark", zone.Remark)
+	d.Set("create_time", zone.CreateTime)
+
+	return nil
+}
+
+func resourceDNSZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	d.Partial(true)
+
+	// remark
+	if d.HasChange("remark") {
+		params := client.ModifyUDNSZoneRemarkRequest{
+			DNSZoneId: d.Id(),
+			Remark:    d.Get("remark").(string),
+		}
+		var resp client.ModifyUDNSZoneRemarkResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("remark")
+	}
+
+	// vpc_ids
+	if d.HasChange("vpc_ids") {
+		o, n := d.GetChange("vpc_ids")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+
+		for _, vpcId := range expandStringSet(oldSet.Difference(newSet)) {
+			params := client.UnbindUDNSZoneVPCRequest{
+				DNSZoneId: d.Id(),
+				VPCId:     vpcId,
+			}
+			var resp client.UnbindUDNSZoneVPCResponse
+			err := apiClient.Call(&params, &resp)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, vpcId := range expandStringSet(newSet.Difference(oldSet)) {
+			err := bindDNSZoneVPC(apiClient, d.Id(), vpcId)
+			if err != nil {
+				return err
+			}
+		}
+		d.SetPartial("vpc_ids")
+	}
+
+	d.Partial(false)
+
+	return resourceDNSZoneRead(d, meta)
+}
+
+func resourceDNSZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteUDNSZoneRequest{DNSZoneId: d.Id()}
+	var resp client.DeleteUDNSZoneResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func bindDNSZoneVPC(apiClient *client.Client, zoneId, vpcId string) error {
+	params := client.BindUDNSZoneVPCRequest{
+		DNSZoneId: zoneId,
+		VPCId:     vpcId,
+	}
+	var resp client.BindUDNSZoneVPCResponse
+	return apiClient.Call(&params, &resp)
+}
+
+func describeDNSZone(apiClient *client.Client, zoneId string) (*client.UDNSZone, error) {
+	params := client.DescribeUDNSZoneRequest{
+		DNSZoneIds: []string{zoneId},
+	}
+
+	var resp client.DescribeUDNSZoneResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DNSZoneInfos {
+		if resp.DNSZoneInfos[i].DNSZoneId == zoneId {
+			return &resp.DNSZoneInfos[i], nil
+		}
+	}
+
+	return nil, nil
+}
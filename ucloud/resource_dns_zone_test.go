@@ -0,0 +1,68 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceDNSZone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_PEER_VPC_ID") },
+		IDRefreshName: "ucloud_dns_zone.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSZoneConfig(`vpc_ids = ["%[1]s"]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_dns_zone.foo", "name", "tf-test.internal"),
+					resource.TestCheckResourceAttr("ucloud_dns_zone.foo", "vpc_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSZoneConfig(`vpc_ids = ["%[1]s", "%[2]s"]`, `remark = "test"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_dns_zone.foo", "vpc_ids.#", "2"),
+					resource.TestCheckResourceAttr("ucloud_dns_zone.foo", "remark", "test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDNSZoneConfig returns a zone named tf-test.internal, vpcIds may refer
+// to UCLOUD_VPC_ID as %[1]s and UCLOUD_PEER_VPC_ID as %[2]s.
+func testAccDNSZoneConfig(vpcIds, extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_dns_zone" "foo" {
+	name = "tf-test.internal"
+	`+vpcIds+`
+	`+extra+`
+}
+`, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_PEER_VPC_ID"))
+}
+
+func testAccCheckDNSZoneDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_dns_zone" {
+			continue
+		}
+
+		zone, err := describeDNSZone(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if zone != nil {
+			return fmt.Errorf("Found undeleted DNS zone: %+v", zone)
+		}
+	}
+
+	return nil
+}
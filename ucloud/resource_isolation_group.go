@@ -0,0 +1,108 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceIsolationGroup manages a UHost isolation group. UHosts placed in
+// the same group with isolation_group never share a physical host.
+func resourceIsolationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIsolationGroupCreate,
+		Read:   resourceIsolationGroupRead,
+		Delete: resourceIsolationGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIsolationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateIsolationGroupRequest{
+		GroupName: d.Get("name").(string),
+		Remark:    d.Get("remark").(string),
+	}
+
+	var resp client.CreateIsolationGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.GroupId)
+
+	return resourceIsolationGroupRead(d, meta)
+}
+
+func resourceIsolationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	group, err := describeIsolationGroup(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		log.Printf("[WARN] Isolation group %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", group.GroupName)
+	d.Set("remark", group.Remark)
+
+	return nil
+}
+
+func resourceIsolationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteIsolationGroupRequest{GroupId: d.Id()}
+	var resp client.DeleteIsolationGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeIsolationGroup(apiClient *client.Client, groupId string) (*client.IsolationGroup, error) {
+	params := client.DescribeIsolationGroupRequest{
+		GroupId: groupId,
+	}
+
+	var resp client.DescribeIsolationGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.IsolationGroupSet {
+		if resp.IsolationGroupSet[i].GroupId == groupId {
+			return &resp.IsolationGroupSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,77 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceIsolationGroup(t *testing.T) {
+	var host client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_isolation_group.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckIsolationGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccIsolationGroupConfig, os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_isolation_group.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_isolation_group.foo", "remark", "bar"),
+					resource.TestCheckResourceAttrPair("ucloud_uhost.foo", "isolation_group", "ucloud_isolation_group.foo", "id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ucloud_isolation_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIsolationGroupConfig = `
+resource "ucloud_isolation_group" "foo" {
+	name = "foo"
+	remark = "bar"
+}
+
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = 1
+	memory = 1024
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Dynamic"
+	isolation_group = "${ucloud_isolation_group.foo.id}"
+}
+`
+
+func testAccCheckIsolationGroupDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_isolation_group" {
+			continue
+		}
+
+		group, err := describeIsolationGroup(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			return fmt.Errorf("Found undeleted isolation group: %+v", group)
+		}
+	}
+
+	return nil
+}
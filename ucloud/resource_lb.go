@@ -0,0 +1,234 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLB() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBCreate,
+		Read:   resourceLBRead,
+		Update: resourceLBUpdate,
+		Delete: resourceLBDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"internal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Creates an inner mode ULB serving the VPC only, instead of an outer mode one serving the internet",
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"ip_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLBCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateULBRequest{}
+	if d.Get("internal").(bool) {
+		params.InnerMode = "Yes"
+	} else {
+		params.OuterMode = "Yes"
+	}
+	if v, ok := d.GetOk("vpc_id"); ok {
+		params.VPCId = v.(string)
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		params.SubnetId = v.(string)
+	}
+	if v, ok := d.GetOk("charge_type"); ok {
+		params.ChargeType = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.ULBName = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		params.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("remark"); ok {
+		params.Remark = v.(string)
+	}
+
+	var resp client.CreateULBResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.ULBId)
+
+	return resourceLBRead(d, meta)
+}
+
+func resourceLBRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	ulb, err := describeULB(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if ulb == nil {
+		log.Printf("[WARN] ULB %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ipSet := make([]map[string]interface{}, 0, len(ulb.IPSet))
+	for _, ip := range ulb.IPSet {
+		ipSet = append(ipSet, map[string]interface{}{
+			"operator_name": ip.OperatorName,
+			"eip":           ip.EIP,
+			"eip_id":        ip.EIPId,
+		})
+	}
+
+	d.Set("internal", ulb.ULBType == "InnerMode")
+	d.Set("vpc_id", ulb.VPCId)
+	d.Set("subnet_id", ulb.SubnetId)
+	d.Set("name", ulb.Name)
+	d.Set("tag", ulb.Tag)
+	d.Set("remark", ulb.Remark)
+	d.Set("private_ip", ulb.PrivateIP)
+	d.Set("create_time", ulb.CreateTime)
+	d.Set("expire_time", ulb.ExpireTime)
+	d.Set("ip_set", ipSet)
+
+	return nil
+}
+
+func resourceLBUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("name") || d.HasChange("tag") || d.HasChange("remark") {
+		params := client.UpdateULBAttributeRequest{
+			ULBId:  d.Id(),
+			Name:   d.Get("name").(string),
+			Tag:    d.Get("tag").(string),
+			Remark: d.Get("remark").(string),
+		}
+		var resp client.UpdateULBAttributeResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceLBRead(d, meta)
+}
+
+func resourceLBDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteULBRequest{ULBId: d.Id()}
+	var resp client.DeleteULBResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeULB(apiClient *client.Client, ulbId string) (*client.ULB, error) {
+	params := client.DescribeULBRequest{
+		ULBId: ulbId,
+	}
+
+	var resp client.DescribeULBResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].ULBId == ulbId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
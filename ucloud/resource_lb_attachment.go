@@ -0,0 +1,253 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBAttachment adds a backend, such as a ucloud_uhost, to a ULB
+// listener.
+func resourceLBAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBAttachmentCreate,
+		Read:   resourceLBAttachmentRead,
+		Update: resourceLBAttachmentUpdate,
+		Delete: resourceLBAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLBAttachmentImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UHost",
+				ForceNew: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      80,
+				ValidateFunc: validateIntBetween(1, 65535),
+			},
+
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health check status of the backend, normal or failure",
+			},
+		},
+	}
+}
+
+func resourceLBAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	ulbId := d.Get("load_balancer_id").(string)
+	vserverId := d.Get("listener_id").(string)
+
+	params := client.AllocateBackendRequest{
+		ULBId:        ulbId,
+		VServerId:    vserverId,
+		ResourceType: d.Get("resource_type").(string),
+		ResourceId:   d.Get("resource_id").(string),
+		Port:         d.Get("port").(int),
+		Enabled:      enabledFlag(d.Get("enabled").(bool)),
+	}
+	if v, ok := d.GetOk("weight"); ok {
+		params.Weight = v.(int)
+	}
+
+	var resp client.AllocateBackendResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	id := resp.BackendId
+	d.SetId(id)
+
+	// a disabled backend is never checked
+	if d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] Waiting for ULB backend (%s) to become normal", id)
+
+		stateConf := newStateChangeConf(
+			[]string{"failure"},
+			[]string{"normal"},
+			backendRefreshFunc(apiClient, ulbId, vserverId, id),
+			d.Timeout(schema.TimeoutCreate),
+		)
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for ULB backend (%s) to become normal: %s", id, err)
+		}
+	}
+
+	return resourceLBAttachmentRead(d, meta)
+}
+
+func resourceLBAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	backend, err := describeBackend(apiClient, d.Get("load_balancer_id").(string), d.Get("listener_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if backend == nil {
+		log.Printf("[WARN] ULB backend %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_type", backend.ResourceType)
+	d.Set("resource_id", backend.ResourceId)
+	d.Set("port", backend.Port)
+	d.Set("weight", backend.Weight)
+	d.Set("enabled", backend.Enabled == 1)
+	d.Set("private_ip", backend.PrivateIP)
+	d.Set("status", backendStatus(backend))
+
+	return nil
+}
+
+func resourceLBAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.UpdateBackendAttributeRequest{
+		ULBId:     d.Get("load_balancer_id").(string),
+		BackendId: d.Id(),
+		Port:      d.Get("port").(int),
+		Weight:    d.Get("weight").(int),
+		Enabled:   enabledFlag(d.Get("enabled").(bool)),
+	}
+	var resp client.UpdateBackendAttributeResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceLBAttachmentRead(d, meta)
+}
+
+func resourceLBAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.ReleaseBackendRequest{
+		ULBId:     d.Get("load_balancer_id").(string),
+		BackendId: d.Id(),
+	}
+	var resp client.ReleaseBackendResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceLBAttachmentImport accepts
+// <load_balancer_id>:<listener_id>:<backend_id>.
+func resourceLBAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseCompositeId(d.Id(), "load_balancer_id", "listener_id", "backend_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("load_balancer_id", parts[0])
+	d.Set("listener_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeBackend(apiClient *client.Client, ulbId, vserverId, backendId string) (*client.ULBBackend, error) {
+	vserver, err := describeVServer(apiClient, ulbId, vserverId)
+	if err != nil || vserver == nil {
+		return nil, err
+	}
+
+	for i := range vserver.BackendSet {
+		if vserver.BackendSet[i].BackendId == backendId {
+			return &vserver.BackendSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func backendRefreshFunc(apiClient *client.Client, ulbId, vserverId, backendId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backend, err := describeBackend(apiClient, ulbId, vserverId, backendId)
+		if err != nil {
+			return nil, "", err
+		}
+		if backend == nil {
+			return nil, "", fmt.Errorf("Backend not found")
+		}
+
+		return backend, backendStatus(backend), nil
+	}
+}
+
+func backendStatus(backend *client.ULBBackend) string {
+	if backend.Status == 0 {
+		return "normal"
+	}
+
+	return "failure"
+}
+
+func enabledFlag(enabled bool) string {
+	if enabled {
+		return "1"
+	}
+
+	return "0"
+}
@@ -0,0 +1,48 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceLBAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID", "UCLOUD_UHOST_ID") },
+		IDRefreshName: "ucloud_lb_attachment.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBConfig("foo", fmt.Sprintf(testAccLBListenerConfig, "Roundrobin")+
+					fmt.Sprintf(testAccLBAttachmentConfig, os.Getenv("UCLOUD_UHOST_ID"), "true")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_attachment.foo", "resource_id", os.Getenv("UCLOUD_UHOST_ID")),
+					resource.TestCheckResourceAttr("ucloud_lb_attachment.foo", "port", "80"),
+					resource.TestCheckResourceAttr("ucloud_lb_attachment.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("ucloud_lb_attachment.foo", "status", "normal"),
+					resource.TestCheckResourceAttrSet("ucloud_lb_attachment.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBConfig("foo", fmt.Sprintf(testAccLBListenerConfig, "Roundrobin")+
+					fmt.Sprintf(testAccLBAttachmentConfig, os.Getenv("UCLOUD_UHOST_ID"), "false")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_attachment.foo", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBAttachmentConfig = `
+resource "ucloud_lb_attachment" "foo" {
+	load_balancer_id = "${ucloud_lb.foo.id}"
+	listener_id = "${ucloud_lb_listener.foo.id}"
+	resource_id = "%s"
+	port = 80
+	enabled = %s
+}
+`
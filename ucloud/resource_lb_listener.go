@@ -0,0 +1,247 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBListener manages a VServer of a ULB.
+func resourceLBListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBListenerCreate,
+		Read:   resourceLBListenerRead,
+		Update: resourceLBListenerUpdate,
+		Delete: resourceLBListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLBListenerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"HTTP", "HTTPS", "TCP", "UDP"}),
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntBetween(1, 65535),
+			},
+
+			"listen_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RequestProxy",
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"RequestProxy", "PacketsTransmit"}),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Roundrobin",
+				ValidateFunc: validateAllowedStringValue([]string{
+					"Roundrobin", "WeightRoundrobin", "Leastconn", "Source", "SourcePort", "ConsistentHash", "ConsistentHashPort",
+				}),
+			},
+
+			"persistence_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "None",
+				ValidateFunc: validateAllowedStringValue([]string{"None", "ServerInsert", "UserDefined"}),
+			},
+
+			"persistence": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cookie name when persistence_type is UserDefined",
+			},
+
+			"idle_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"health_check_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Port",
+				ValidateFunc: validateAllowedStringValue([]string{"Port", "Path"}),
+			},
+
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host header of the health check when health_check_type is Path",
+			},
+
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the health check when health_check_type is Path",
+			},
+
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLBListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateVServerRequest{
+		ULBId:           d.Get("load_balancer_id").(string),
+		Protocol:        d.Get("protocol").(string),
+		FrontendPort:    d.Get("port").(int),
+		ListenType:      d.Get("listen_type").(string),
+		Method:          d.Get("method").(string),
+		PersistenceType: d.Get("persistence_type").(string),
+		PersistenceInfo: d.Get("persistence").(string),
+		MonitorType:     d.Get("health_check_type").(string),
+		Domain:          d.Get("domain").(string),
+		Path:            d.Get("path").(string),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.VServerName = v.(string)
+	}
+	if v, ok := d.GetOk("idle_timeout"); ok {
+		params.ClientTimeout = v.(int)
+	}
+
+	var resp client.CreateVServerResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.VServerId)
+
+	return resourceLBListenerRead(d, meta)
+}
+
+func resourceLBListenerRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	vserver, err := describeVServer(apiClient, d.Get("load_balancer_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if vserver == nil {
+		log.Printf("[WARN] ULB listener %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protocol", vserver.Protocol)
+	d.Set("port", vserver.FrontendPort)
+	d.Set("listen_type", vserver.ListenType)
+	d.Set("name", vserver.VServerName)
+	d.Set("method", vserver.Method)
+	d.Set("persistence_type", vserver.PersistenceType)
+	d.Set("persistence", vserver.PersistenceInfo)
+	d.Set("idle_timeout", vserver.ClientTimeout)
+	d.Set("health_check_type", vserver.MonitorType)
+	d.Set("domain", vserver.Domain)
+	d.Set("path", vserver.Path)
+	d.Set("status", vserver.Status)
+
+	return nil
+}
+
+func resourceLBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.UpdateVServerAttributeRequest{
+		ULBId:           d.Get("load_balancer_id").(string),
+		VServerId:       d.Id(),
+		VServerName:     d.Get("name").(string),
+		Method:          d.Get("method").(string),
+		PersistenceType: d.Get("persistence_type").(string),
+		PersistenceInfo: d.Get("persistence").(string),
+		ClientTimeout:   d.Get("idle_timeout").(int),
+		MonitorType:     d.Get("health_check_type").(string),
+		Domain:          d.Get("domain").(string),
+		Path:            d.Get("path").(string),
+	}
+	var resp client.UpdateVServerAttributeResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceLBListenerRead(d, meta)
+}
+
+func resourceLBListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteVServerRequest{
+		ULBId:     d.Get("load_balancer_id").(string),
+		VServerId: d.Id(),
+	}
+	var resp client.DeleteVServerResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceLBListenerImport accepts <load_balancer_id>:<listener_id>.
+func resourceLBListenerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseCompositeId(d.Id(), "load_balancer_id", "listener_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("load_balancer_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeVServer(apiClient *client.Client, ulbId, vserverId string) (*client.ULBVServer, error) {
+	params := client.DescribeVServerRequest{
+		ULBId:     ulbId,
+		VServerId: vserverId,
+	}
+
+	var resp client.DescribeVServerResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].VServerId == vserverId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceLBListener(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		IDRefreshName: "ucloud_lb_listener.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBConfig("foo", fmt.Sprintf(testAccLBListenerConfig, "Roundrobin")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "protocol", "HTTP"),
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "port", "80"),
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "method", "Roundrobin"),
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "health_check_type", "Path"),
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "path", "/health"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBConfig("foo", fmt.Sprintf(testAccLBListenerConfig, "Source")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_listener.foo", "method", "Source"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBListenerConfig = `
+resource "ucloud_lb_listener" "foo" {
+	load_balancer_id = "${ucloud_lb.foo.id}"
+	name = "foo"
+	protocol = "HTTP"
+	port = 80
+	method = "%s"
+	persistence_type = "ServerInsert"
+	health_check_type = "Path"
+	path = "/health"
+}
+`
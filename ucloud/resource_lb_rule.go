@@ -0,0 +1,256 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBRule manages a content forwarding rule, which is called policy in
+// the ULB API. Requests to the listener matching either domain or path are
+// forwarded to the given backends, which are ids of ucloud_lb_attachment.
+func resourceLBRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBRuleCreate,
+		Read:   resourceLBRuleRead,
+		Update: resourceLBRuleUpdate,
+		Delete: resourceLBRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLBRuleImport,
+		},
+
+		CustomizeDiff: resourceLBRuleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"domain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"path"},
+				ValidateFunc:  validateLBRuleDomain,
+				Description:   "Domain such as api.example.com or *.example.com, or a regular expression prefixed with ~",
+			},
+
+			"path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"domain"},
+				ValidateFunc:  validateLBRulePath,
+				Description:   "Path prefix such as /api, or a regular expression prefixed with ~",
+			},
+
+			"backend_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLBRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	matchType, match, err := lbRuleMatch(d)
+	if err != nil {
+		return err
+	}
+
+	params := client.CreatePolicyRequest{
+		ULBId:     d.Get("load_balancer_id").(string),
+		VServerId: d.Get("listener_id").(string),
+		BackendId: expandStringSet(d.Get("backend_ids").(*schema.Set)),
+		Type:      matchType,
+		Match:     match,
+	}
+
+	var resp client.CreatePolicyResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.PolicyId)
+
+	return resourceLBRuleRead(d, meta)
+}
+
+func resourceLBRuleRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	vserver, err := describeVServer(apiClient, d.Get("load_balancer_id").(string), d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+	var policy *client.ULBPolicy
+	if vserver != nil {
+		for i := range vserver.PolicySet {
+			if vserver.PolicySet[i].PolicyId == d.Id() {
+				policy = &vserver.PolicySet[i]
+			}
+		}
+	}
+	if policy == nil {
+		log.Printf("[WARN] ULB rule %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	backendIds := make([]string, 0, len(policy.BackendSet))
+	for _, backend := range policy.BackendSet {
+		backendIds = append(backendIds, backend.BackendId)
+	}
+
+	d.Set("domain", "")
+	d.Set("path", "")
+	if policy.Type == "Domain" {
+		d.Set("domain", policy.Match)
+	} else {
+		d.Set("path", policy.Match)
+	}
+	d.Set("backend_ids", backendIds)
+	d.Set("priority", policy.PolicyPriority)
+
+	return nil
+}
+
+func resourceLBRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	matchType, match, err := lbRuleMatch(d)
+	if err != nil {
+		return err
+	}
+
+	params := client.UpdatePolicyRequest{
+		ULBId:     d.Get("load_balancer_id").(string),
+		VServerId: d.Get("listener_id").(string),
+		PolicyId:  d.Id(),
+		BackendId: expandStringSet(d.Get("backend_ids").(*schema.Set)),
+		Type:      matchType,
+		Match:     match,
+	}
+	var resp client.UpdatePolicyResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceLBRuleRead(d, meta)
+}
+
+func resourceLBRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeletePolicyRequest{
+		PolicyId:  d.Id(),
+		VServerId: d.Get("listener_id").(string),
+	}
+	var resp client.DeletePolicyResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceLBRuleImport accepts <load_balancer_id>:<listener_id>:<rule_id>.
+func resourceLBRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseCompositeId(d.Id(), "load_balancer_id", "listener_id", "rule_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("load_balancer_id", parts[0])
+	d.Set("listener_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceLBRuleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("domain") || !d.NewValueKnown("path") {
+		return nil
+	}
+	if d.Get("domain").(string) == "" && d.Get("path").(string) == "" {
+		return fmt.Errorf("One of domain and path is required")
+	}
+
+	return nil
+}
+
+func lbRuleMatch(d *schema.ResourceData) (string, string, error) {
+	if v := d.Get("domain").(string); v != "" {
+		return "Domain", v, nil
+	}
+	if v := d.Get("path").(string); v != "" {
+		return "Path", v, nil
+	}
+
+	return "", "", fmt.Errorf("One of domain and path is required")
+}
+
+var lbRuleDomainRegexp = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`)
+
+func validateLBRuleDomain(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.HasPrefix(value, "~") {
+		return validateLBRuleRegexp(value, k)
+	}
+
+	if !lbRuleDomainRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must be a domain such as api.example.com or *.example.com, or a regular expression prefixed with ~, got %q", k, value))
+	}
+
+	return
+}
+
+func validateLBRulePath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.HasPrefix(value, "~") {
+		return validateLBRuleRegexp(value, k)
+	}
+
+	if !strings.HasPrefix(value, "/") || strings.ContainsAny(value, " ?#") {
+		errors = append(errors, fmt.Errorf("%s must be a path starting with /, or a regular expression prefixed with ~, got %q", k, value))
+	}
+
+	return
+}
+
+// validateLBRuleRegexp only warns about expressions Go cannot compile, as ULB
+// matches with PCRE, which also supports lookarounds and backreferences.
+func validateLBRuleRegexp(value string, k string) (ws []string, errors []error) {
+	expr := strings.TrimSpace(strings.TrimPrefix(value, "~"))
+	if expr == "" {
+		errors = append(errors, fmt.Errorf("%s has an empty regular expression", k))
+	} else if _, err := regexp.Compile(expr); err != nil {
+		ws = append(ws, fmt.Sprintf("%s has a regular expression %q which could not be checked, make sure it is valid PCRE: %s", k, expr, err))
+	}
+
+	return
+}
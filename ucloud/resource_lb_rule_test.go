@@ -0,0 +1,101 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceLBRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID", "UCLOUD_UHOST_ID") },
+		IDRefreshName: "ucloud_lb_rule.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBRuleConfig(`path = "/api"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_rule.foo", "path", "/api"),
+					resource.TestCheckResourceAttr("ucloud_lb_rule.foo", "domain", ""),
+					resource.TestCheckResourceAttr("ucloud_lb_rule.foo", "backend_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBRuleConfig(`domain = "~^api\\d+\\.example\\.com$"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_lb_rule.foo", "path", ""),
+					resource.TestCheckResourceAttr("ucloud_lb_rule.foo", "domain", `~^api\d+\.example\.com$`),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBRuleConfig(match string) string {
+	return testAccLBConfig("foo", fmt.Sprintf(testAccLBListenerConfig, "Roundrobin")+
+		fmt.Sprintf(testAccLBAttachmentConfig, os.Getenv("UCLOUD_UHOST_ID"), "true")+`
+resource "ucloud_lb_rule" "foo" {
+	load_balancer_id = "${ucloud_lb.foo.id}"
+	listener_id = "${ucloud_lb_listener.foo.id}"
+	backend_ids = ["${ucloud_lb_attachment.foo.id}"]
+	`+match+`
+}
+`)
+}
+
+func TestValidateLBRuleMatch(t *testing.T) {
+	cases := []struct {
+		Validate func(interface{}, string) ([]string, []error)
+		Value    string
+		Valid    bool
+	}{
+		{validateLBRuleDomain, "api.example.com", true},
+		{validateLBRuleDomain, "*.example.com", true},
+		{validateLBRuleDomain, `~^api\d+\.example\.com$`, true},
+		{validateLBRuleDomain, "api", false},
+		{validateLBRuleDomain, "api.*.com", false},
+		// left to ULB, which matches with PCRE
+		{validateLBRuleDomain, "~(", true},
+		{validateLBRulePath, "/api", true},
+		{validateLBRulePath, "/", true},
+		{validateLBRulePath, "~ ^/static/.*\\.js$", true},
+		{validateLBRulePath, "~^/(?!admin)", true},
+		{validateLBRulePath, "api", false},
+		{validateLBRulePath, "/api?x=1", false},
+		{validateLBRulePath, "~", false},
+	}
+
+	for _, tc := range cases {
+		_, errors := tc.Validate(tc.Value, "match")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Errorf("Expect validity of %q to be %t but got errors: %v", tc.Value, tc.Valid, errors)
+		}
+	}
+}
+
+func TestResourceLBRuleDiffMatch(t *testing.T) {
+	cases := []struct {
+		Match map[string]interface{}
+		Error string
+	}{
+		{map[string]interface{}{"domain": "api.example.com"}, ""},
+		{map[string]interface{}{"path": "/api"}, ""},
+		{map[string]interface{}{}, "One of domain and path is required"},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"load_balancer_id": "ulb-foo",
+			"listener_id":      "vserver-foo",
+			"backend_ids":      []interface{}{"backend-foo"},
+		}
+		for k, v := range tc.Match {
+			raw[k] = v
+		}
+
+		testResourceDiff(t, resourceLBRule(), nil, raw, nil, tc.Error)
+	}
+}
@@ -0,0 +1,140 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBSSL uploads a certificate for HTTPS listeners. Certificates
+// cannot be modified, so every change creates a new one, see
+// resourceLBSSLAttachment for rotating them without downtime.
+func resourceLBSSL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBSSLCreate,
+		Read:   resourceLBSSLRead,
+		Delete: resourceLBSSLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"private_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validatePEM,
+			},
+
+			"user_cert": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePEM,
+			},
+
+			"ca_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePEM,
+			},
+
+			"hash_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLBSSLCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateSSLRequest{
+		SSLName:    d.Get("name").(string),
+		SSLType:    "Pem",
+		PrivateKey: d.Get("private_key").(string),
+		UserCert:   d.Get("user_cert").(string),
+		CaCert:     d.Get("ca_cert").(string),
+	}
+
+	var resp client.CreateSSLResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.SSLId)
+
+	return resourceLBSSLRead(d, meta)
+}
+
+func resourceLBSSLRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	ssl, err := describeSSL(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if ssl == nil {
+		log.Printf("[WARN] ULB SSL certificate %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the PEM content is not returned, so it is kept as configured
+	d.Set("name", ssl.SSLName)
+	d.Set("hash_value", ssl.HashValue)
+	d.Set("create_time", ssl.CreateTime)
+
+	return nil
+}
+
+func resourceLBSSLDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteSSLRequest{SSLId: d.Id()}
+	var resp client.DeleteSSLResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeSSL(apiClient *client.Client, sslId string) (*client.ULBSSL, error) {
+	params := client.DescribeSSLRequest{
+		SSLId: sslId,
+	}
+
+	var resp client.DescribeSSLResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].SSLId == sslId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,146 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBSSLAttachment binds a certificate to an HTTPS listener. To rotate
+// a certificate, create the replacement with create_before_destroy: binding
+// the new certificate takes over the listener, and unbinding the old one is
+// skipped once it no longer serves the listener.
+func resourceLBSSLAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBSSLAttachmentCreate,
+		Read:   resourceLBSSLAttachmentRead,
+		Delete: resourceLBSSLAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ssl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLBSSLAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.BindSSLRequest{
+		SSLId:     d.Get("ssl_id").(string),
+		ULBId:     d.Get("load_balancer_id").(string),
+		VServerId: d.Get("listener_id").(string),
+	}
+
+	var resp client.BindSSLResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.SSLId, params.ULBId, params.VServerId))
+
+	return resourceLBSSLAttachmentRead(d, meta)
+}
+
+func resourceLBSSLAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	sslId, ulbId, vserverId, err := parseLBSSLAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	bound, err := isSSLBound(apiClient, sslId, ulbId, vserverId)
+	if err != nil {
+		return err
+	}
+	if !bound {
+		log.Printf("[WARN] ULB SSL attachment %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ssl_id", sslId)
+	d.Set("load_balancer_id", ulbId)
+	d.Set("listener_id", vserverId)
+
+	return nil
+}
+
+func resourceLBSSLAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	sslId, ulbId, vserverId, err := parseLBSSLAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// already replaced by another certificate
+	bound, err := isSSLBound(apiClient, sslId, ulbId, vserverId)
+	if err != nil {
+		return err
+	}
+	if !bound {
+		d.SetId("")
+		return nil
+	}
+
+	params := client.UnbindSSLRequest{
+		SSLId:     sslId,
+		ULBId:     ulbId,
+		VServerId: vserverId,
+	}
+	var resp client.UnbindSSLResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseLBSSLAttachmentId(id string) (string, string, string, error) {
+	parts, err := parseCompositeId(id, "ssl_id", "load_balancer_id", "listener_id")
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func isSSLBound(apiClient *client.Client, sslId, ulbId, vserverId string) (bool, error) {
+	ssl, err := describeSSL(apiClient, sslId)
+	if err != nil || ssl == nil {
+		return false, err
+	}
+
+	for _, target := range ssl.BindedTargetSet {
+		if target.ULBId == ulbId && target.VServerId == vserverId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
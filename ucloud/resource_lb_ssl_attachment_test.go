@@ -0,0 +1,53 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceLBSSLAttachment(t *testing.T) {
+	cert, key := testAccGenerateCertificate(t, "foo.example.com")
+	newCert, newKey := testAccGenerateCertificate(t, "foo.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		IDRefreshName: "ucloud_lb_ssl_attachment.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBSSLDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBConfig("foo", testAccLBSSLConfig(cert, key)+testAccLBSSLAttachmentConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ucloud_lb_ssl_attachment.foo", "ssl_id", "ucloud_lb_ssl.foo", "id"),
+					resource.TestCheckResourceAttrPair("ucloud_lb_ssl_attachment.foo", "listener_id", "ucloud_lb_listener.https", "id"),
+				),
+			},
+			// rotate the certificate
+			resource.TestStep{
+				Config: testAccLBConfig("foo", testAccLBSSLConfig(newCert, newKey)+testAccLBSSLAttachmentConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ucloud_lb_ssl_attachment.foo", "ssl_id", "ucloud_lb_ssl.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBSSLAttachmentConfig = `
+resource "ucloud_lb_listener" "https" {
+	load_balancer_id = "${ucloud_lb.foo.id}"
+	protocol = "HTTPS"
+	port = 443
+}
+
+resource "ucloud_lb_ssl_attachment" "foo" {
+	ssl_id = "${ucloud_lb_ssl.foo.id}"
+	load_balancer_id = "${ucloud_lb.foo.id}"
+	listener_id = "${ucloud_lb_listener.https.id}"
+
+	lifecycle {
+		create_before_destroy = true
+	}
+}
+`
@@ -0,0 +1,130 @@
+package ucloud
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceLBSSL(t *testing.T) {
+	var ssl client.ULBSSL
+	cert, key := testAccGenerateCertificate(t, "foo.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_lb_ssl.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBSSLDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBSSLConfig(cert, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBSSLExists("ucloud_lb_ssl.foo", &ssl),
+					resource.TestCheckResourceAttr("ucloud_lb_ssl.foo", "name", "foo"),
+					resource.TestCheckResourceAttrSet("ucloud_lb_ssl.foo", "hash_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBSSLConfig(cert, key string) string {
+	return fmt.Sprintf(`
+resource "ucloud_lb_ssl" "foo" {
+	name = "foo"
+	user_cert = <<EOF
+%sEOF
+	private_key = <<EOF
+%sEOF
+
+	lifecycle {
+		create_before_destroy = true
+	}
+}
+`, cert, key)
+}
+
+// testAccGenerateCertificate returns a self-signed certificate and its
+// private key in PEM.
+func testAccGenerateCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal("Failed to generate key: ", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal("Failed to create certificate: ", err)
+	}
+
+	var cert, privateKey bytes.Buffer
+	pem.Encode(&cert, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	pem.Encode(&privateKey, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return cert.String(), privateKey.String()
+}
+
+func testAccCheckLBSSLDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_lb_ssl" {
+			continue
+		}
+
+		ssl, err := describeSSL(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ssl != nil {
+			return fmt.Errorf("Found undeleted ULB SSL certificate: %+v", ssl)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBSSLExists(n string, i *client.ULBSSL) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		ssl, err := describeSSL(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ssl == nil {
+			return fmt.Errorf("ULB SSL certificate not found")
+		}
+
+		*i = *ssl
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceLB(t *testing.T) {
+	var ulb client.ULB
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		IDRefreshName: "ucloud_lb.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckLBDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBConfig("foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBExists("ucloud_lb.foo", &ulb),
+					resource.TestCheckResourceAttr("ucloud_lb.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_lb.foo", "internal", "true"),
+					resource.TestCheckResourceAttr("ucloud_lb.foo", "vpc_id", os.Getenv("UCLOUD_VPC_ID")),
+					resource.TestCheckResourceAttrSet("ucloud_lb.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBConfig("foox", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBExists("ucloud_lb.foo", &ulb),
+					resource.TestCheckResourceAttr("ucloud_lb.foo", "name", "foox"),
+				),
+			},
+		},
+	})
+}
+
+// testAccLBConfig returns an inner mode ULB named foo followed by extra, so
+// the listener and attachment tests can share it.
+func testAccLBConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_lb" "foo" {
+	name = "%s"
+	tag = "test"
+	internal = true
+	vpc_id = "%s"
+	subnet_id = "%s"
+}
+%s`, name, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_SUBNET_ID"), extra)
+}
+
+func testAccCheckLBDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_lb" {
+			continue
+		}
+
+		ulb, err := describeULB(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ulb != nil {
+			return fmt.Errorf("Found undeleted ULB: %+v", ulb)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBExists(n string, i *client.ULB) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		ulb, err := describeULB(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ulb == nil {
+			return fmt.Errorf("ULB not found")
+		}
+
+		*i = *ulb
+		return nil
+	}
+}
@@ -0,0 +1,287 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceMemcacheInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMemcacheInstanceCreate,
+		Read:   resourceMemcacheInstanceRead,
+		Update: resourceMemcacheInstanceUpdate,
+		Delete: resourceMemcacheInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMemcacheInstanceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size in GB",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"quantity": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "Periods bought at create, not read back so changes are ignored afterwards",
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemcacheInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	params := client.CreateUMemcacheGroupRequest{
+		Zone:       zone,
+		Name:       d.Get("name").(string),
+		Version:    d.Get("engine_version").(string),
+		Size:       d.Get("memory").(int),
+		ChargeType: d.Get("charge_type").(string),
+		Quantity:   d.Get("quantity").(int),
+		VPCId:      d.Get("vpc_id").(string),
+		SubnetId:   d.Get("subnet_id").(string),
+	}
+
+	var resp client.CreateUMemcacheGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.GroupId)
+
+	log.Printf("[DEBUG] Waiting for memcache instance (%s) to become running", d.Id())
+
+	err = waitForMemcacheInstance(apiClient, zone, d.Id(), []string{"Creating", "Starting"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for memcache instance (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceMemcacheInstanceRead(d, meta)
+}
+
+func resourceMemcacheInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	group, err := describeUMemcacheGroup(apiClient, d.Get("zone").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		log.Printf("[WARN] Memcache instance %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("zone", group.Zone)
+	d.Set("engine_version", group.Version)
+	d.Set("memory", group.Size)
+	d.Set("name", group.Name)
+	d.Set("charge_type", group.ChargeType)
+	d.Set("vpc_id", group.VPCId)
+	d.Set("subnet_id", group.SubnetId)
+	d.Set("private_ip", group.VirtualIP)
+	d.Set("port", group.Port)
+	d.Set("state", group.State)
+	d.Set("create_time", group.CreateTime)
+	d.Set("expire_time", group.ExpireTime)
+
+	return nil
+}
+
+func resourceMemcacheInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	// memory is the only field which can be changed in place
+	if d.HasChange("memory") {
+		zone := d.Get("zone").(string)
+		params := client.ResizeUMemcacheGroupRequest{
+			Zone:    zone,
+			GroupId: d.Id(),
+			Size:    d.Get("memory").(int),
+		}
+		var resp client.ResizeUMemcacheGroupResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+
+		err = waitForMemcacheInstance(apiClient, zone, d.Id(), []string{"Resizing"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for memcache instance (%s) to be resized: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMemcacheInstanceRead(d, meta)
+}
+
+// resourceMemcacheInstanceImport accepts <zone>:<id>, or the plain id of an
+// instance which is then looked up in every zone of the region.
+func resourceMemcacheInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	apiClient := meta.(*client.Client)
+
+	zone, id := "", d.Id()
+	if parts, err := parseCompositeId(d.Id(), "zone", "id"); err == nil {
+		zone, id = parts[0], parts[1]
+	}
+
+	group, err := describeUMemcacheGroup(apiClient, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("Memcache instance %s not found", d.Id())
+	}
+
+	d.SetId(id)
+	d.Set("zone", group.Zone)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMemcacheInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteUMemcacheGroupRequest{
+		Zone:    d.Get("zone").(string),
+		GroupId: d.Id(),
+	}
+	var resp client.DeleteUMemcacheGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeUMemcacheGroup(apiClient *client.Client, zone, groupId string) (*client.UMemcacheGroup, error) {
+	params := client.DescribeUMemcacheGroupRequest{
+		Zone:    zone,
+		GroupId: groupId,
+	}
+
+	var resp client.DescribeUMemcacheGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].GroupId == groupId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func memcacheInstanceRefreshFunc(apiClient *client.Client, zone, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		group, err := describeUMemcacheGroup(apiClient, zone, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if group == nil {
+			return nil, "", fmt.Errorf("Memcache instance not found")
+		}
+
+		return group, group.State, nil
+	}
+}
+
+func waitForMemcacheInstance(apiClient *client.Client, zone, id string, pending []string, timeout time.Duration) error {
+	stateConf := newStateChangeConf(
+		pending,
+		[]string{"Running"},
+		memcacheInstanceRefreshFunc(apiClient, zone, id),
+		timeout,
+	)
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,104 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceMemcacheInstance(t *testing.T) {
+	var group client.UMemcacheGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		IDRefreshName: "ucloud_memcache_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckMemcacheInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMemcacheInstanceConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemcacheInstanceExists("ucloud_memcache_instance.foo", &group),
+					resource.TestCheckResourceAttr("ucloud_memcache_instance.foo", "memory", "1"),
+					resource.TestCheckResourceAttr("ucloud_memcache_instance.foo", "subnet_id", os.Getenv("UCLOUD_SUBNET_ID")),
+					resource.TestCheckResourceAttrSet("ucloud_memcache_instance.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccMemcacheInstanceConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemcacheInstanceExists("ucloud_memcache_instance.foo", &group),
+					resource.TestCheckResourceAttr("ucloud_memcache_instance.foo", "memory", "2"),
+					resource.TestCheckResourceAttr("ucloud_memcache_instance.foo", "state", "Running"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ucloud_memcache_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"quantity"},
+			},
+		},
+	})
+}
+
+func testAccMemcacheInstanceConfig(memory int) string {
+	return fmt.Sprintf(`
+resource "ucloud_memcache_instance" "foo" {
+	zone = "%s"
+	name = "foo"
+	memory = %d
+	vpc_id = "%s"
+	subnet_id = "%s"
+}
+`, os.Getenv("UCLOUD_ZONE"), memory, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_SUBNET_ID"))
+}
+
+func testAccCheckMemcacheInstanceDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_memcache_instance" {
+			continue
+		}
+
+		group, err := describeUMemcacheGroup(apiClient, rs.Primary.Attributes["zone"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			return fmt.Errorf("Found undeleted memcache instance: %+v", group)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckMemcacheInstanceExists(n string, i *client.UMemcacheGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		group, err := describeUMemcacheGroup(apiClient, rs.Primary.Attributes["zone"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("Memcache instance not found")
+		}
+
+		*i = *group
+		return nil
+	}
+}
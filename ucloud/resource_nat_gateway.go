@@ -0,0 +1,192 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNATGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNATGatewayCreate,
+		Read:   resourceNATGatewayRead,
+		Update: resourceNATGatewayUpdate,
+		Delete: resourceNATGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"firewall_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "FirewallId of the security group applied to the NAT gateway",
+			},
+
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"eip_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNATGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateNATGWRequest{
+		VPCId:         d.Get("vpc_id").(string),
+		FirewallId:    d.Get("firewall_id").(string),
+		SubnetworkIds: expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		EIPIds:        expandStringSet(d.Get("eip_ids").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.NATGWName = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		params.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("remark"); ok {
+		params.Remark = v.(string)
+	}
+
+	var resp client.CreateNATGWResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.NATGWId)
+
+	return resourceNATGatewayRead(d, meta)
+}
+
+func resourceNATGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgw, err := describeNATGW(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if natgw == nil {
+		log.Printf("[WARN] NAT gateway %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	subnetIds := make([]string, 0, len(natgw.SubnetSet))
+	for _, subnet := range natgw.SubnetSet {
+		subnetIds = append(subnetIds, subnet.SubnetworkId)
+	}
+	eipIds := make([]string, 0, len(natgw.IPSet))
+	for _, ip := range natgw.IPSet {
+		eipIds = append(eipIds, ip.EIPId)
+	}
+
+	d.Set("vpc_id", natgw.VPCId)
+	d.Set("firewall_id", natgw.FirewallId)
+	d.Set("subnet_ids", subnetIds)
+	d.Set("eip_ids", eipIds)
+	d.Set("name", natgw.NATGWName)
+	d.Set("tag", natgw.Tag)
+	d.Set("remark", natgw.Remark)
+	d.Set("create_time", natgw.CreateTime)
+
+	return nil
+}
+
+func resourceNATGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("subnet_ids") {
+		params := client.UpdateNATGWSubnetRequest{
+			NATGWId:       d.Id(),
+			SubnetworkIds: expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		}
+		var resp client.UpdateNATGWSubnetResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNATGatewayRead(d, meta)
+}
+
+func resourceNATGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteNATGWRequest{NATGWId: d.Id()}
+	var resp client.DeleteNATGWResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeNATGW(apiClient *client.Client, natgwId string) (*client.NATGW, error) {
+	params := client.DescribeNATGWRequest{
+		NATGWIds: []string{natgwId},
+	}
+
+	var resp client.DescribeNATGWResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].NATGWId == natgwId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
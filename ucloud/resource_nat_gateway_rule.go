@@ -0,0 +1,228 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNATGatewayRule manages a DNAT port forwarding rule, which is called
+// policy in the NAT gateway API.
+func resourceNATGatewayRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNATGatewayRuleCreate,
+		Read:   resourceNATGatewayRuleRead,
+		Update: resourceNATGatewayRuleUpdate,
+		Delete: resourceNATGatewayRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "TCP" && value != "UDP" {
+						errors = append(errors, fmt.Errorf("%s can only be TCP or UDP", k))
+					}
+
+					return
+				},
+			},
+
+			"src_eip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"src_port": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Port or port range such as 8000-8010 on the EIP",
+			},
+
+			"dst_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"dst_port": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Port or port range on the private host, must have the same size as src_port",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"src_eip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNATGatewayRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateNATGWPolicyRequest{
+		NATGWId:  d.Get("nat_gateway_id").(string),
+		Protocol: d.Get("protocol").(string),
+		SrcEIPId: d.Get("src_eip_id").(string),
+		SrcPort:  d.Get("src_port").(string),
+		DstIP:    d.Get("dst_ip").(string),
+		DstPort:  d.Get("dst_port").(string),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.PolicyName = v.(string)
+	}
+
+	var resp client.CreateNATGWPolicyResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.NATGWId, resp.PolicyId))
+
+	return resourceNATGatewayRuleRead(d, meta)
+}
+
+func resourceNATGatewayRuleRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, policyId, err := parseNATGatewayRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	natgw, err := describeNATGW(apiClient, natgwId)
+	if err != nil {
+		return err
+	}
+	if natgw == nil {
+		log.Printf("[WARN] NAT gateway %s is gone, removing rule %s from state", natgwId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy, err := describeNATGWPolicy(apiClient, natgwId, policyId)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		log.Printf("[WARN] NAT gateway rule %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", natgwId)
+	d.Set("protocol", policy.Protocol)
+	d.Set("src_eip_id", policy.SrcEIPId)
+	d.Set("src_eip", policy.SrcEIP)
+	d.Set("src_port", policy.SrcPort)
+	d.Set("dst_ip", policy.DstIP)
+	d.Set("dst_port", policy.DstPort)
+	d.Set("name", policy.PolicyName)
+
+	return nil
+}
+
+func resourceNATGatewayRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, policyId, err := parseNATGatewayRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.UpdateNATGWPolicyRequest{
+		NATGWId:    natgwId,
+		PolicyId:   policyId,
+		Protocol:   d.Get("protocol").(string),
+		SrcEIPId:   d.Get("src_eip_id").(string),
+		SrcPort:    d.Get("src_port").(string),
+		DstIP:      d.Get("dst_ip").(string),
+		DstPort:    d.Get("dst_port").(string),
+		PolicyName: d.Get("name").(string),
+	}
+	var resp client.UpdateNATGWPolicyResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceNATGatewayRuleRead(d, meta)
+}
+
+func resourceNATGatewayRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, policyId, err := parseNATGatewayRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.DeleteNATGWPolicyRequest{
+		NATGWId:  natgwId,
+		PolicyId: policyId,
+	}
+	var resp client.DeleteNATGWPolicyResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseNATGatewayRuleId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "nat_gateway_id", "policy_id")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeNATGWPolicy(apiClient *client.Client, natgwId, policyId string) (*client.NATGWPolicy, error) {
+	params := client.DescribeNATGWPolicyRequest{
+		NATGWId: natgwId,
+		Limit:   100,
+	}
+
+	for {
+		var resp client.DescribeNATGWPolicyResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.DataSet {
+			if resp.DataSet[i].PolicyId == policyId {
+				return &resp.DataSet[i], nil
+			}
+		}
+
+		params.Offset += params.Limit
+		if len(resp.DataSet) < params.Limit || params.Offset >= resp.TotalCount {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceNATGatewayRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, testAccNATGatewayEnv...) },
+		IDRefreshName: "ucloud_nat_gateway_rule.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNATGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNATGatewayConfig(fmt.Sprintf(testAccNATGatewayRuleConfig, os.Getenv("UCLOUD_EIP_ID"), "80")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_rule.foo", "protocol", "TCP"),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_rule.foo", "src_port", "8080"),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_rule.foo", "dst_port", "80"),
+					resource.TestCheckResourceAttrSet("ucloud_nat_gateway_rule.foo", "src_eip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNATGatewayConfig(fmt.Sprintf(testAccNATGatewayRuleConfig, os.Getenv("UCLOUD_EIP_ID"), "8000")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_rule.foo", "dst_port", "8000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNATGatewayRuleConfig = `
+resource "ucloud_nat_gateway_rule" "foo" {
+	nat_gateway_id = "${ucloud_nat_gateway.foo.id}"
+	name = "foo"
+	protocol = "TCP"
+	src_eip_id = "%s"
+	src_port = "8080"
+	dst_ip = "10.9.0.10"
+	dst_port = "%s"
+}
+`
@@ -0,0 +1,188 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNATGatewaySNATRule pins the outbound address of a private IP to one
+// of the EIPs bound to the NAT gateway.
+func resourceNATGatewaySNATRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNATGatewaySNATRuleCreate,
+		Read:   resourceNATGatewaySNATRuleRead,
+		Update: resourceNATGatewaySNATRuleUpdate,
+		Delete: resourceNATGatewaySNATRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"snat_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNATGatewaySNATRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateSnatRuleRequest{
+		NATGWId:  d.Get("nat_gateway_id").(string),
+		SourceIp: d.Get("source_ip").(string),
+		SnatIp:   d.Get("snat_ip").(string),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = v.(string)
+	}
+
+	var resp client.CreateSnatRuleResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.NATGWId, params.SourceIp))
+
+	return resourceNATGatewaySNATRuleRead(d, meta)
+}
+
+func resourceNATGatewaySNATRuleRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, sourceIp, err := parseNATGatewaySNATRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	natgw, err := describeNATGW(apiClient, natgwId)
+	if err != nil {
+		return err
+	}
+	if natgw == nil {
+		log.Printf("[WARN] NAT gateway %s is gone, removing SNAT rule %s from state", natgwId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rule, err := describeSnatRule(apiClient, natgwId, sourceIp)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		log.Printf("[WARN] SNAT rule %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", natgwId)
+	d.Set("source_ip", rule.SourceIp)
+	d.Set("snat_ip", rule.SnatIp)
+	d.Set("name", rule.Name)
+	d.Set("subnet_id", rule.SubnetworkId)
+
+	return nil
+}
+
+func resourceNATGatewaySNATRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, sourceIp, err := parseNATGatewaySNATRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.UpdateSnatRuleRequest{
+		NATGWId:  natgwId,
+		SourceIp: sourceIp,
+		SnatIp:   d.Get("snat_ip").(string),
+		Name:     d.Get("name").(string),
+	}
+	var resp client.UpdateSnatRuleResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceNATGatewaySNATRuleRead(d, meta)
+}
+
+func resourceNATGatewaySNATRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	natgwId, sourceIp, err := parseNATGatewaySNATRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.DeleteSnatRuleRequest{
+		NATGWId:  natgwId,
+		SourceIp: sourceIp,
+	}
+	var resp client.DeleteSnatRuleResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseNATGatewaySNATRuleId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "nat_gateway_id", "source_ip")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeSnatRule(apiClient *client.Client, natgwId, sourceIp string) (*client.SnatRule, error) {
+	params := client.DescribeSnatRuleRequest{
+		NATGWId:  natgwId,
+		SourceIp: sourceIp,
+	}
+
+	var resp client.DescribeSnatRuleResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].SourceIp == sourceIp {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
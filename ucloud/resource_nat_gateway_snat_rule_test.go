@@ -0,0 +1,43 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceNATGatewaySNATRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, append(testAccNATGatewayEnv, "UCLOUD_EIP")...) },
+		IDRefreshName: "ucloud_nat_gateway_snat_rule.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNATGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNATGatewayConfig(fmt.Sprintf(testAccNATGatewaySNATRuleConfig, "foo", os.Getenv("UCLOUD_EIP"))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_snat_rule.foo", "source_ip", "10.9.0.10"),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_snat_rule.foo", "name", "foo"),
+					resource.TestCheckResourceAttrSet("ucloud_nat_gateway_snat_rule.foo", "subnet_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNATGatewayConfig(fmt.Sprintf(testAccNATGatewaySNATRuleConfig, "foox", os.Getenv("UCLOUD_EIP"))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_nat_gateway_snat_rule.foo", "name", "foox"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNATGatewaySNATRuleConfig = `
+resource "ucloud_nat_gateway_snat_rule" "foo" {
+	nat_gateway_id = "${ucloud_nat_gateway.foo.id}"
+	name = "%s"
+	source_ip = "10.9.0.10"
+	snat_ip = "%s"
+}
+`
@@ -0,0 +1,95 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccNATGatewayEnv = []string{"UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID", "UCLOUD_FIREWALL_ID", "UCLOUD_EIP_ID"}
+
+func TestAccResourceNATGateway(t *testing.T) {
+	var natgw client.NATGW
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, testAccNATGatewayEnv...) },
+		IDRefreshName: "ucloud_nat_gateway.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNATGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNATGatewayConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNATGatewayExists("ucloud_nat_gateway.foo", &natgw),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway.foo", "vpc_id", os.Getenv("UCLOUD_VPC_ID")),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway.foo", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("ucloud_nat_gateway.foo", "eip_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccNATGatewayConfig returns a NAT gateway named foo followed by extra,
+// so the rule tests can share it.
+func testAccNATGatewayConfig(extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_nat_gateway" "foo" {
+	name = "foo"
+	vpc_id = "%s"
+	subnet_ids = ["%s"]
+	firewall_id = "%s"
+	eip_ids = ["%s"]
+}
+%s`, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_SUBNET_ID"), os.Getenv("UCLOUD_FIREWALL_ID"), os.Getenv("UCLOUD_EIP_ID"), extra)
+}
+
+func testAccCheckNATGatewayDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_nat_gateway" {
+			continue
+		}
+
+		natgw, err := describeNATGW(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if natgw != nil {
+			return fmt.Errorf("Found undeleted NAT gateway: %+v", natgw)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNATGatewayExists(n string, i *client.NATGW) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		natgw, err := describeNATGW(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if natgw == nil {
+			return fmt.Errorf("NAT gateway not found")
+		}
+
+		*i = *natgw
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkACLCreate,
+		Read:   resourceNetworkACLRead,
+		Update: resourceNetworkACLUpdate,
+		Delete: resourceNetworkACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkACLImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateNetworkAclRequest{
+		VpcId:       d.Get("vpc_id").(string),
+		AclName:     d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	var resp client.CreateNetworkAclResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.AclId)
+
+	return resourceNetworkACLRead(d, meta)
+}
+
+func resourceNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	acl, err := describeNetworkACL(apiClient, d.Get("vpc_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if acl == nil {
+		log.Printf("[WARN] Network ACL %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_id", acl.VpcId)
+	d.Set("name", acl.AclName)
+	d.Set("description", acl.Description)
+	d.Set("create_time", acl.CreateTime)
+
+	return nil
+}
+
+func resourceNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		params := client.UpdateNetworkAclRequest{
+			AclId:       d.Id(),
+			AclName:     d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		var resp client.UpdateNetworkAclResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkACLRead(d, meta)
+}
+
+func resourceNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteNetworkAclRequest{AclId: d.Id()}
+	var resp client.DeleteNetworkAclResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceNetworkACLImport accepts <vpc_id>:<acl_id>, since ACLs can only be
+// listed per VPC.
+func resourceNetworkACLImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseCompositeId(d.Id(), "vpc_id", "acl_id")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("vpc_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeNetworkACL(apiClient *client.Client, vpcId, aclId string) (*client.NetworkAcl, error) {
+	params := client.DescribeNetworkAclRequest{
+		VpcId: vpcId,
+		Limit: 100,
+	}
+
+	for {
+		var resp client.DescribeNetworkAclResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.AclList {
+			if resp.AclList[i].AclId == aclId {
+				return &resp.AclList[i], nil
+			}
+		}
+
+		if len(resp.AclList) < params.Limit {
+			return nil, nil
+		}
+		params.Offset += params.Limit
+	}
+}
@@ -0,0 +1,118 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkACLAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkACLAssociationCreate,
+		Read:   resourceNetworkACLAssociationRead,
+		Delete: resourceNetworkACLAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateNetworkAclAssociationRequest{
+		AclId:        d.Get("acl_id").(string),
+		SubnetworkId: d.Get("subnet_id").(string),
+	}
+
+	var resp client.CreateNetworkAclAssociationResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.AclId, params.SubnetworkId))
+
+	return resourceNetworkACLAssociationRead(d, meta)
+}
+
+func resourceNetworkACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	aclId, subnetId, err := parseNetworkACLAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.DescribeNetworkAclAssociationBySubnetRequest{SubnetworkId: subnetId}
+	var resp client.DescribeNetworkAclAssociationBySubnetResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	// a subnet has at most one ACL, which may have been replaced
+	if resp.Association == nil || resp.Association.AclId != aclId {
+		log.Printf("[WARN] Network ACL association %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("acl_id", aclId)
+	d.Set("subnet_id", subnetId)
+	d.Set("association_id", resp.Association.AssociationId)
+
+	return nil
+}
+
+func resourceNetworkACLAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	aclId, subnetId, err := parseNetworkACLAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.DeleteNetworkAclAssociationRequest{
+		AclId:        aclId,
+		SubnetworkId: subnetId,
+	}
+	var resp client.DeleteNetworkAclAssociationResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseNetworkACLAssociationId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "acl_id", "subnet_id")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,34 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceNetworkACLAssociation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		IDRefreshName: "ucloud_network_acl_association.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkACLConfig("foo", fmt.Sprintf(testAccNetworkACLAssociationConfig, os.Getenv("UCLOUD_SUBNET_ID"))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_network_acl_association.foo", "subnet_id", os.Getenv("UCLOUD_SUBNET_ID")),
+					resource.TestCheckResourceAttrSet("ucloud_network_acl_association.foo", "association_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkACLAssociationConfig = `
+resource "ucloud_network_acl_association" "foo" {
+	acl_id = "${ucloud_network_acl.foo.id}"
+	subnet_id = "%s"
+}
+`
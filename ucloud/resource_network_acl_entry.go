@@ -0,0 +1,273 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetworkACLEntry manages a rule of a network ACL. Entries of the same
+// direction are evaluated in ascending order of priority, so two of them
+// cannot share a priority.
+func resourceNetworkACLEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkACLEntryCreate,
+		Read:   resourceNetworkACLEntryRead,
+		Update: resourceNetworkACLEntryUpdate,
+		Delete: resourceNetworkACLEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceNetworkACLEntryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Ingress", "Egress"}),
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(1, math.MaxInt32),
+				Description:  "Entries with smaller priority are evaluated first",
+			},
+
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"TCP", "UDP", "ICMP", "GRE", "ALL"}),
+			},
+
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDRNetwork,
+			},
+
+			"port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validatePortRange,
+				Description:  "Port or port range such as 8000-8010, required for TCP and UDP",
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Accept", "Reject"}),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkACLEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	err := checkNetworkACLEntryPortRange(d.Get("protocol").(string), d.Get("port_range").(string))
+	if err != nil {
+		return err
+	}
+
+	params := client.CreateNetworkAclEntryRequest{
+		AclId:       d.Get("acl_id").(string),
+		Priority:    d.Get("priority").(int),
+		Direction:   d.Get("direction").(string),
+		IpProtocol:  d.Get("protocol").(string),
+		CidrBlock:   d.Get("cidr_block").(string),
+		PortRange:   d.Get("port_range").(string),
+		EntryAction: d.Get("action").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err = checkNetworkACLEntryPriority(apiClient, params.AclId, "", params.Direction, params.Priority)
+	if err != nil {
+		return err
+	}
+
+	var resp client.CreateNetworkAclEntryResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.AclId, resp.EntryId))
+
+	return resourceNetworkACLEntryRead(d, meta)
+}
+
+func resourceNetworkACLEntryRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	aclId, entryId, err := parseNetworkACLEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	entries, err := describeNetworkACLEntries(apiClient, aclId)
+	if err != nil {
+		return err
+	}
+
+	var entry *client.NetworkAclEntry
+	for i := range entries {
+		if entries[i].EntryId == entryId {
+			entry = &entries[i]
+		}
+	}
+	if entry == nil {
+		log.Printf("[WARN] Network ACL entry %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("acl_id", aclId)
+	d.Set("direction", entry.Direction)
+	d.Set("priority", entry.Priority)
+	d.Set("protocol", entry.IpProtocol)
+	d.Set("cidr_block", entry.CidrBlock)
+	d.Set("port_range", entry.PortRange)
+	d.Set("action", entry.EntryAction)
+	d.Set("description", entry.Description)
+
+	return nil
+}
+
+func resourceNetworkACLEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	aclId, entryId, err := parseNetworkACLEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = checkNetworkACLEntryPortRange(d.Get("protocol").(string), d.Get("port_range").(string))
+	if err != nil {
+		return err
+	}
+
+	params := client.UpdateNetworkAclEntryRequest{
+		AclId:       aclId,
+		EntryId:     entryId,
+		Priority:    d.Get("priority").(int),
+		Direction:   d.Get("direction").(string),
+		IpProtocol:  d.Get("protocol").(string),
+		CidrBlock:   d.Get("cidr_block").(string),
+		PortRange:   d.Get("port_range").(string),
+		EntryAction: d.Get("action").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if d.HasChange("priority") || d.HasChange("direction") {
+		err = checkNetworkACLEntryPriority(apiClient, aclId, entryId, params.Direction, params.Priority)
+		if err != nil {
+			return err
+		}
+	}
+
+	var resp client.UpdateNetworkAclEntryResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetworkACLEntryRead(d, meta)
+}
+
+func resourceNetworkACLEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	aclId, entryId, err := parseNetworkACLEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.DeleteNetworkAclEntryRequest{
+		AclId:   aclId,
+		EntryId: entryId,
+	}
+	var resp client.DeleteNetworkAclEntryResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceNetworkACLEntryCustomizeDiff checks port_range against the protocol
+// at plan time when both are known, Create and Update check them again
+// otherwise.
+func resourceNetworkACLEntryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("protocol") || !d.NewValueKnown("port_range") {
+		return nil
+	}
+
+	return checkNetworkACLEntryPortRange(d.Get("protocol").(string), d.Get("port_range").(string))
+}
+
+func checkNetworkACLEntryPortRange(protocol, portRange string) error {
+	if (protocol == "TCP" || protocol == "UDP") && portRange == "" {
+		return fmt.Errorf("port_range is required for protocol %s", protocol)
+	}
+	if protocol != "TCP" && protocol != "UDP" && portRange != "" {
+		return fmt.Errorf("port_range is not supported for protocol %s", protocol)
+	}
+
+	return nil
+}
+
+// checkNetworkACLEntryPriority fails if priority is used by another entry
+// than entryId of the same direction.
+func checkNetworkACLEntryPriority(apiClient *client.Client, aclId, entryId, direction string, priority int) error {
+	entries, err := describeNetworkACLEntries(apiClient, aclId)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.EntryId != entryId && entry.Direction == direction && entry.Priority == priority {
+			return fmt.Errorf("Priority %d of %s entries in network ACL %s is already used by %s", priority, direction, aclId, entry.EntryId)
+		}
+	}
+
+	return nil
+}
+
+func parseNetworkACLEntryId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "acl_id", "entry_id")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeNetworkACLEntries(apiClient *client.Client, aclId string) ([]client.NetworkAclEntry, error) {
+	params := client.DescribeNetworkAclEntryRequest{AclId: aclId}
+
+	var resp client.DescribeNetworkAclEntryResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.EntryList, nil
+}
@@ -0,0 +1,81 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceNetworkACLEntry(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID") },
+		IDRefreshName: "ucloud_network_acl_entry.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkACLConfig("foo", fmt.Sprintf(testAccNetworkACLEntryConfig, "10.0.0.0/8", "Accept")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "direction", "Ingress"),
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "priority", "100"),
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "port_range", "22"),
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "action", "Accept"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkACLConfig("foo", fmt.Sprintf(testAccNetworkACLEntryConfig, "10.1.0.0/16", "Reject")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr("ucloud_network_acl_entry.foo", "action", "Reject"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccNetworkACLConfig("foo", fmt.Sprintf(testAccNetworkACLEntryConfig, "10.1.0.1/16", "Reject")),
+				ExpectError: regexp.MustCompile("host bits set"),
+			},
+		},
+	})
+}
+
+const testAccNetworkACLEntryConfig = `
+resource "ucloud_network_acl_entry" "foo" {
+	acl_id = "${ucloud_network_acl.foo.id}"
+	direction = "Ingress"
+	priority = 100
+	protocol = "TCP"
+	cidr_block = "%s"
+	port_range = "22"
+	action = "%s"
+}
+`
+
+func TestResourceNetworkACLEntryDiffPortRange(t *testing.T) {
+	cases := []struct {
+		Protocol, PortRange string
+		Error               string
+	}{
+		{"TCP", "80", ""},
+		{"ICMP", "", ""},
+		{"UDP", "", "port_range is required for protocol UDP"},
+		{"ALL", "8000-8010", "port_range is not supported for protocol ALL"},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"acl_id":     "acl-foo",
+			"direction":  "Ingress",
+			"priority":   100,
+			"protocol":   tc.Protocol,
+			"cidr_block": "10.0.0.0/8",
+			"action":     "Accept",
+		}
+		if tc.PortRange != "" {
+			raw["port_range"] = tc.PortRange
+		}
+
+		testResourceDiff(t, resourceNetworkACLEntry(), nil, raw, nil, tc.Error)
+	}
+}
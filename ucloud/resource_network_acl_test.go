@@ -0,0 +1,96 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceNetworkACL(t *testing.T) {
+	var acl client.NetworkAcl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID") },
+		IDRefreshName: "ucloud_network_acl.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkACLConfig("foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLExists("ucloud_network_acl.foo", &acl),
+					resource.TestCheckResourceAttr("ucloud_network_acl.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_network_acl.foo", "description", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkACLConfig("foox", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLExists("ucloud_network_acl.foo", &acl),
+					resource.TestCheckResourceAttr("ucloud_network_acl.foo", "name", "foox"),
+				),
+			},
+		},
+	})
+}
+
+// testAccNetworkACLConfig returns a network ACL named foo followed by extra,
+// so the entry and association tests can share it.
+func testAccNetworkACLConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_network_acl" "foo" {
+	vpc_id = "%s"
+	name = "%s"
+	description = "bar"
+}
+%s`, os.Getenv("UCLOUD_VPC_ID"), name, extra)
+}
+
+func testAccCheckNetworkACLDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_network_acl" {
+			continue
+		}
+
+		acl, err := describeNetworkACL(apiClient, rs.Primary.Attributes["vpc_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if acl != nil {
+			return fmt.Errorf("Found undeleted network ACL: %+v", acl)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkACLExists(n string, i *client.NetworkAcl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		acl, err := describeNetworkACL(apiClient, rs.Primary.Attributes["vpc_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if acl == nil {
+			return fmt.Errorf("Network ACL not found")
+		}
+
+		*i = *acl
+		return nil
+	}
+}
@@ -0,0 +1,482 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceRedisInstance manages a master-slave redis, which is a URedis
+// group, or a distributed one, which is a UMem space speaking the redis
+// protocol. Both are resized online.
+func resourceRedisInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRedisInstanceCreate,
+		Read:   resourceRedisInstanceRead,
+		Update: resourceRedisInstanceUpdate,
+		Delete: resourceRedisInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRedisInstanceImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceRedisInstanceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "master-slave",
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"master-slave", "distributed"}),
+			},
+
+			"engine_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Redis version such as 3.2 or 4.0, only for master-slave",
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size in GB",
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Only for master-slave",
+			},
+
+			"charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"quantity": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "Periods bought at create, not read back so changes are ignored afterwards",
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	instanceType := d.Get("instance_type").(string)
+
+	if instanceType == "distributed" {
+		params := client.CreateUMemSpaceRequest{
+			Zone:       zone,
+			Name:       d.Get("name").(string),
+			Protocol:   "redis",
+			Size:       d.Get("memory").(int),
+			ChargeType: d.Get("charge_type").(string),
+			Quantity:   d.Get("quantity").(int),
+			VPCId:      d.Get("vpc_id").(string),
+			SubnetId:   d.Get("subnet_id").(string),
+		}
+		var resp client.CreateUMemSpaceResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(resp.SpaceId)
+	} else {
+		params := client.CreateURedisGroupRequest{
+			Zone:             zone,
+			Name:             d.Get("name").(string),
+			HighAvailability: "enable",
+			Version:          d.Get("engine_version").(string),
+			Size:             d.Get("memory").(int),
+			Password:         d.Get("password").(string),
+			ChargeType:       d.Get("charge_type").(string),
+			Quantity:         d.Get("quantity").(int),
+			VPCId:            d.Get("vpc_id").(string),
+			SubnetId:         d.Get("subnet_id").(string),
+		}
+		var resp client.CreateURedisGroupResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(resp.GroupId)
+	}
+
+	log.Printf("[DEBUG] Waiting for redis instance (%s) to become running", d.Id())
+
+	err := waitForRedisInstance(apiClient, zone, d.Id(), instanceType, []string{"Creating", "Starting"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for redis instance (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceRedisInstanceRead(d, meta)
+}
+
+func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+
+	if d.Get("instance_type").(string) == "distributed" {
+		space, err := describeUMemSpace(apiClient, zone, d.Id())
+		if err != nil {
+			return err
+		}
+		if space == nil {
+			log.Printf("[WARN] Redis instance %s is gone, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("zone", space.Zone)
+		d.Set("name", space.Name)
+		d.Set("memory", space.Size)
+		d.Set("charge_type", space.ChargeType)
+		d.Set("vpc_id", space.VPCId)
+		d.Set("subnet_id", space.SubnetId)
+		if len(space.Address) > 0 {
+			d.Set("private_ip", space.Address[0].IP)
+			d.Set("port", space.Address[0].Port)
+		}
+		d.Set("state", space.State)
+		d.Set("create_time", space.CreateTime)
+		d.Set("expire_time", space.ExpireTime)
+
+		return nil
+	}
+
+	group, err := describeURedisGroup(apiClient, zone, d.Id())
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		log.Printf("[WARN] Redis instance %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the password is not returned, so it is kept as configured
+	d.Set("zone", group.Zone)
+	d.Set("name", group.Name)
+	d.Set("engine_version", group.Version)
+	d.Set("memory", group.Size)
+	d.Set("charge_type", group.ChargeType)
+	d.Set("vpc_id", group.VPCId)
+	d.Set("subnet_id", group.SubnetId)
+	d.Set("private_ip", group.VirtualIP)
+	d.Set("port", group.Port)
+	d.Set("state", group.State)
+	d.Set("create_time", group.CreateTime)
+	d.Set("expire_time", group.ExpireTime)
+
+	return nil
+}
+
+func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+	instanceType := d.Get("instance_type").(string)
+	distributed := instanceType == "distributed"
+
+	d.Partial(true)
+
+	// name
+	if d.HasChange("name") {
+		var err error
+		if distributed {
+			params := client.ModifyUMemSpaceNameRequest{
+				Zone:    zone,
+				SpaceId: d.Id(),
+				Name:    d.Get("name").(string),
+			}
+			var resp client.ModifyUMemSpaceNameResponse
+			err = apiClient.Call(&params, &resp)
+		} else {
+			params := client.ModifyURedisGroupNameRequest{
+				Zone:    zone,
+				GroupId: d.Id(),
+				Name:    d.Get("name").(string),
+			}
+			var resp client.ModifyURedisGroupNameResponse
+			err = apiClient.Call(&params, &resp)
+		}
+		if err != nil {
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	// password
+	if d.HasChange("password") {
+		if distributed {
+			return fmt.Errorf("password is only supported by master-slave redis")
+		}
+
+		params := client.ModifyURedisGroupPasswordRequest{
+			Zone:     zone,
+			GroupId:  d.Id(),
+			Password: d.Get("password").(string),
+		}
+		var resp client.ModifyURedisGroupPasswordResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("password")
+	}
+
+	// resize: online, but has to wait before the next change
+	if d.HasChange("memory") {
+		var err error
+		if distributed {
+			params := client.ResizeUMemSpaceRequest{
+				Zone:    zone,
+				SpaceId: d.Id(),
+				Size:    d.Get("memory").(int),
+			}
+			var resp client.ResizeUMemSpaceResponse
+			err = apiClient.Call(&params, &resp)
+		} else {
+			params := client.ResizeURedisGroupRequest{
+				Zone:    zone,
+				GroupId: d.Id(),
+				Size:    d.Get("memory").(int),
+			}
+			var resp client.ResizeURedisGroupResponse
+			err = apiClient.Call(&params, &resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = waitForRedisInstance(apiClient, zone, d.Id(), instanceType, []string{"Resizing"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for redis instance (%s) to be resized: %s", d.Id(), err)
+		}
+		d.SetPartial("memory")
+	}
+
+	d.Partial(false)
+
+	return resourceRedisInstanceRead(d, meta)
+}
+
+// resourceRedisInstanceImport accepts <zone>:<id>, or the plain id of an
+// instance which is then looked up in every zone of the region. The id is
+// either of a master-slave or of a distributed instance.
+func resourceRedisInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	apiClient := meta.(*client.Client)
+
+	zone, id := "", d.Id()
+	if parts, err := parseCompositeId(d.Id(), "zone", "id"); err == nil {
+		zone, id = parts[0], parts[1]
+	}
+
+	group, err := describeURedisGroup(apiClient, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if group != nil {
+		d.SetId(id)
+		d.Set("zone", group.Zone)
+		d.Set("instance_type", "master-slave")
+		return []*schema.ResourceData{d}, nil
+	}
+
+	space, err := describeUMemSpace(apiClient, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if space != nil {
+		d.SetId(id)
+		d.Set("zone", space.Zone)
+		d.Set("instance_type", "distributed")
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("Redis instance %s not found", d.Id())
+}
+
+func resourceRedisInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("password"); ok && d.Get("instance_type").(string) == "distributed" {
+		return fmt.Errorf("password is only supported by master-slave redis")
+	}
+
+	return nil
+}
+
+func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	zone := d.Get("zone").(string)
+
+	var err error
+	if d.Get("instance_type").(string) == "distributed" {
+		var resp client.DeleteUMemSpaceResponse
+		err = apiClient.Call(&client.DeleteUMemSpaceRequest{Zone: zone, SpaceId: d.Id()}, &resp)
+	} else {
+		var resp client.DeleteURedisGroupResponse
+		err = apiClient.Call(&client.DeleteURedisGroupRequest{Zone: zone, GroupId: d.Id()}, &resp)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeURedisGroup(apiClient *client.Client, zone, groupId string) (*client.URedisGroup, error) {
+	params := client.DescribeURedisGroupRequest{
+		Zone:    zone,
+		GroupId: groupId,
+	}
+
+	var resp client.DescribeURedisGroupResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].GroupId == groupId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func describeUMemSpace(apiClient *client.Client, zone, spaceId string) (*client.UMemSpace, error) {
+	params := client.DescribeUMemSpaceRequest{
+		Zone:    zone,
+		SpaceId: spaceId,
+	}
+
+	var resp client.DescribeUMemSpaceResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].SpaceId == spaceId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func redisInstanceRefreshFunc(apiClient *client.Client, zone, id, instanceType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if instanceType == "distributed" {
+			space, err := describeUMemSpace(apiClient, zone, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if space == nil {
+				return nil, "", fmt.Errorf("Redis instance not found")
+			}
+
+			return space, space.State, nil
+		}
+
+		group, err := describeURedisGroup(apiClient, zone, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if group == nil {
+			return nil, "", fmt.Errorf("Redis instance not found")
+		}
+
+		return group, group.State, nil
+	}
+}
+
+func waitForRedisInstance(apiClient *client.Client, zone, id, instanceType string, pending []string, timeout time.Duration) error {
+	stateConf := newStateChangeConf(
+		pending,
+		[]string{"Running"},
+		redisInstanceRefreshFunc(apiClient, zone, id, instanceType),
+		timeout,
+	)
+
+	_, err := stateConf.WaitForState()
+	return err
+}
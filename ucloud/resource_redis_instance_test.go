@@ -0,0 +1,161 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceRedisInstance_masterSlave(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRedisInstanceConfig("master-slave", "foo", 1, `password = "Terraform-2018"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedisInstanceExists("ucloud_redis_instance.foo"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "memory", "1"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "vpc_id", os.Getenv("UCLOUD_VPC_ID")),
+					resource.TestCheckResourceAttrSet("ucloud_redis_instance.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRedisInstanceConfig("master-slave", "foox", 2, `password = "Terraform-2019"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedisInstanceExists("ucloud_redis_instance.foo"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "name", "foox"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "memory", "2"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "state", "Running"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ucloud_redis_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "quantity"},
+			},
+		},
+	})
+}
+
+func TestAccResourceRedisInstance_distributed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRedisInstanceConfig("distributed", "foo", 16, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedisInstanceExists("ucloud_redis_instance.foo"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "memory", "16"),
+					resource.TestCheckResourceAttrSet("ucloud_redis_instance.foo", "private_ip"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRedisInstanceConfig("distributed", "foo", 32, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRedisInstanceExists("ucloud_redis_instance.foo"),
+					resource.TestCheckResourceAttr("ucloud_redis_instance.foo", "memory", "32"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedisInstanceConfig(instanceType, name string, memory int, extra string) string {
+	return fmt.Sprintf(`
+resource "ucloud_redis_instance" "foo" {
+	zone = "%s"
+	instance_type = "%s"
+	name = "%s"
+	memory = %d
+	vpc_id = "%s"
+	subnet_id = "%s"
+	%s
+}
+`, os.Getenv("UCLOUD_ZONE"), instanceType, name, memory, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_SUBNET_ID"), extra)
+}
+
+func testAccCheckRedisInstanceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_redis_instance" {
+			continue
+		}
+
+		found, err := testAccRedisInstanceFound(rs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Found undeleted redis instance: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRedisInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		found, err := testAccRedisInstanceFound(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Redis instance not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccRedisInstanceFound(rs *terraform.ResourceState) (bool, error) {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	zone := rs.Primary.Attributes["zone"]
+
+	if rs.Primary.Attributes["instance_type"] == "distributed" {
+		space, err := describeUMemSpace(apiClient, zone, rs.Primary.ID)
+		return space != nil, err
+	}
+
+	group, err := describeURedisGroup(apiClient, zone, rs.Primary.ID)
+	return group != nil, err
+}
+
+func TestResourceRedisInstanceDiffPassword(t *testing.T) {
+	cases := []struct {
+		InstanceType string
+		Error        string
+	}{
+		{"master-slave", ""},
+		{"distributed", "password is only supported by master-slave redis"},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"zone":          "cn-bj2-02",
+			"instance_type": tc.InstanceType,
+			"memory":        16,
+			"password":      "Terraform-2018",
+		}
+
+		testResourceDiff(t, resourceRedisInstance(), nil, raw, nil, tc.Error)
+	}
+}
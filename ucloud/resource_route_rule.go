@@ -0,0 +1,212 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceRouteRule manages a single route of a route table, see
+// resourceRouteTable for mixing it with inline routes.
+func resourceRouteRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteRuleCreate,
+		Read:   resourceRouteRuleRead,
+		Update: resourceRouteRuleUpdate,
+		Delete: resourceRouteRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"nexthop_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of the next hop, such as instance or vip",
+			},
+
+			"nexthop_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceRouteRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	tableId := d.Get("route_table_id").(string)
+	destination := d.Get("destination").(string)
+
+	table, err := describeRouteTable(apiClient, tableId)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return fmt.Errorf("Route table %s not found", tableId)
+	}
+	if rule := findRouteRuleByDestination(table, destination); rule != nil {
+		return fmt.Errorf("Route to %s already exists in route table %s as %s, it may be managed inline by ucloud_route_table. "+
+			"Manage each route either inline or with ucloud_route_rule, not both.", destination, tableId, rule.RouteRuleId)
+	}
+
+	params := client.ModifyRouteRuleRequest{
+		RouteTableId: tableId,
+		RouteRule: []client.RouteRuleAction{
+			client.RouteRuleAction{
+				DstAddr:     destination,
+				NexthopType: d.Get("nexthop_type").(string),
+				NexthopId:   d.Get("nexthop_id").(string),
+				Remark:      d.Get("remark").(string),
+				Action:      "add",
+			},
+		},
+	}
+	var resp client.ModifyRouteRuleResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	// ModifyRouteRule does not return the id of the new rule
+	table, err = describeRouteTable(apiClient, tableId)
+	if err != nil {
+		return err
+	}
+	var rule *client.RouteRule
+	if table != nil {
+		rule = findRouteRuleByDestination(table, destination)
+	}
+	if rule == nil {
+		return fmt.Errorf("Cannot find route to %s in route table %s after creation", destination, tableId)
+	}
+
+	d.SetId(buildCompositeId(tableId, rule.RouteRuleId))
+
+	return resourceRouteRuleRead(d, meta)
+}
+
+func resourceRouteRuleRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	tableId, ruleId, err := parseRouteRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	table, err := describeRouteTable(apiClient, tableId)
+	if err != nil {
+		return err
+	}
+	var rule *client.RouteRule
+	if table != nil {
+		for i := range table.RouteRules {
+			if table.RouteRules[i].RouteRuleId == ruleId {
+				rule = &table.RouteRules[i]
+			}
+		}
+	}
+	if rule == nil {
+		log.Printf("[WARN] Route rule %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("route_table_id", tableId)
+	d.Set("destination", rule.DstAddr)
+	d.Set("nexthop_type", rule.NexthopType)
+	d.Set("nexthop_id", rule.NexthopId)
+	d.Set("remark", rule.Remark)
+
+	return nil
+}
+
+func resourceRouteRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	tableId, ruleId, err := parseRouteRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.ModifyRouteRuleRequest{
+		RouteTableId: tableId,
+		RouteRule: []client.RouteRuleAction{
+			client.RouteRuleAction{
+				RouteRuleId: ruleId,
+				DstAddr:     d.Get("destination").(string),
+				NexthopType: d.Get("nexthop_type").(string),
+				NexthopId:   d.Get("nexthop_id").(string),
+				Remark:      d.Get("remark").(string),
+				Action:      "update",
+			},
+		},
+	}
+	var resp client.ModifyRouteRuleResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceRouteRuleRead(d, meta)
+}
+
+func resourceRouteRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	tableId, ruleId, err := parseRouteRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := client.ModifyRouteRuleRequest{
+		RouteTableId: tableId,
+		RouteRule: []client.RouteRuleAction{
+			client.RouteRuleAction{
+				RouteRuleId: ruleId,
+				DstAddr:     d.Get("destination").(string),
+				NexthopType: d.Get("nexthop_type").(string),
+				NexthopId:   d.Get("nexthop_id").(string),
+				Action:      "delete",
+			},
+		},
+	}
+	var resp client.ModifyRouteRuleResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseRouteRuleId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "route_table_id", "route_rule_id")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
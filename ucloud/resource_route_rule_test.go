@@ -0,0 +1,57 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceRouteRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_UHOST_ID") },
+		IDRefreshName: "ucloud_route_rule.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRouteTableConfig("") + testAccRouteRuleConfig("10.100.0.0/16", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_route_rule.foo", "destination", "10.100.0.0/16"),
+					resource.TestCheckResourceAttr("ucloud_route_rule.foo", "nexthop_type", "instance"),
+					resource.TestCheckResourceAttr("ucloud_route_rule.foo", "remark", "foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRouteTableConfig("") + testAccRouteRuleConfig("10.100.0.0/16", "foox"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_route_rule.foo", "remark", "foox"),
+				),
+			},
+			// the inline route conflicts with the standalone one
+			resource.TestStep{
+				Config: testAccRouteTableConfig(`
+	route {
+		destination = "10.100.0.0/16"
+		nexthop_type = "instance"
+		nexthop_id = "%[2]s"
+	}`) + testAccRouteRuleConfig("10.100.0.0/16", "foox"),
+				ExpectError: regexp.MustCompile("not managed inline"),
+			},
+		},
+	})
+}
+
+func testAccRouteRuleConfig(destination, remark string) string {
+	return fmt.Sprintf(`
+resource "ucloud_route_rule" "foo" {
+	route_table_id = "${ucloud_route_table.foo.id}"
+	destination = "%s"
+	nexthop_type = "instance"
+	nexthop_id = "%s"
+	remark = "%s"
+}
+`, destination, os.Getenv("UCLOUD_UHOST_ID"), remark)
+}
@@ -0,0 +1,322 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceRouteTable manages a custom VPC route table. Routes can be managed
+// inline through route blocks or with ucloud_route_rule. The table only
+// tracks the destinations listed inline, or all custom routes when imported,
+// and both resources refuse to take over a destination owned by the other
+// one.
+func resourceRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteTableCreate,
+		Read:   resourceRouteTableRead,
+		Update: resourceRouteTableUpdate,
+		Delete: resourceRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRouteTableImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"remark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"nexthop_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the next hop, such as instance or vip",
+						},
+						"nexthop_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"remark": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"subnet_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateRouteTableRequest{
+		VPCId: d.Get("vpc_id").(string),
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		params.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("remark"); ok {
+		params.Remark = v.(string)
+	}
+
+	var resp client.CreateRouteTableResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.RouteTableId)
+
+	err = updateInlineRoutes(apiClient, d.Id(), nil, d.Get("route").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	return resourceRouteTableRead(d, meta)
+}
+
+func resourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	table, err := describeRouteTable(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		log.Printf("[WARN] Route table %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// only refresh the routes in state, the others may belong to
+	// ucloud_route_rule resources
+	routes := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("route").(*schema.Set).List() {
+		route := v.(map[string]interface{})
+		if rule := findRouteRuleByDestination(table, route["destination"].(string)); rule != nil {
+			routes = append(routes, flattenRouteRule(rule))
+		}
+	}
+
+	d.Set("vpc_id", table.VPCId)
+	d.Set("tag", table.Tag)
+	d.Set("remark", table.Remark)
+	d.Set("route", routes)
+	d.Set("subnet_count", table.SubnetCount)
+	d.Set("create_time", table.CreateTime)
+
+	return nil
+}
+
+// resourceRouteTableImport takes over all the custom routes of the table as
+// inline routes.
+func resourceRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	apiClient := meta.(*client.Client)
+
+	table, err := describeRouteTable(apiClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf("Route table %s not found", d.Id())
+	}
+
+	d.Set("route", flattenCustomRouteRules(table))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		err := updateInlineRoutes(apiClient, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRouteTableRead(d, meta)
+}
+
+func resourceRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteRouteTableRequest{RouteTableId: d.Id()}
+	var resp client.DeleteRouteTableResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// updateInlineRoutes applies the difference between the old and new route
+// blocks in a single ModifyRouteRule call.
+func updateInlineRoutes(apiClient *client.Client, tableId string, oldRoutes, newRoutes []interface{}) error {
+	table, err := describeRouteTable(apiClient, tableId)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return fmt.Errorf("Route table %s not found", tableId)
+	}
+
+	oldByDestination := make(map[string]map[string]interface{}, len(oldRoutes))
+	for _, v := range oldRoutes {
+		route := v.(map[string]interface{})
+		oldByDestination[route["destination"].(string)] = route
+	}
+	newByDestination := make(map[string]map[string]interface{}, len(newRoutes))
+	for _, v := range newRoutes {
+		route := v.(map[string]interface{})
+		newByDestination[route["destination"].(string)] = route
+	}
+
+	var actions []client.RouteRuleAction
+	for destination := range oldByDestination {
+		if _, ok := newByDestination[destination]; ok {
+			continue
+		}
+		if rule := findRouteRuleByDestination(table, destination); rule != nil {
+			actions = append(actions, client.RouteRuleAction{
+				RouteRuleId: rule.RouteRuleId,
+				DstAddr:     rule.DstAddr,
+				NexthopType: rule.NexthopType,
+				NexthopId:   rule.NexthopId,
+				Action:      "delete",
+			})
+		}
+	}
+
+	for destination, route := range newByDestination {
+		action := client.RouteRuleAction{
+			DstAddr:     destination,
+			NexthopType: route["nexthop_type"].(string),
+			NexthopId:   route["nexthop_id"].(string),
+			Remark:      route["remark"].(string),
+		}
+
+		oldRoute, managed := oldByDestination[destination]
+		rule := findRouteRuleByDestination(table, destination)
+		switch {
+		case rule == nil:
+			action.Action = "add"
+		case !managed:
+			return fmt.Errorf("Route to %s already exists in route table %s but is not managed inline, "+
+				"it is probably managed by ucloud_route_rule. Manage each route either inline or with ucloud_route_rule, not both.", destination, tableId)
+		case !reflect.DeepEqual(oldRoute, route):
+			action.RouteRuleId = rule.RouteRuleId
+			action.Action = "update"
+		default:
+			continue
+		}
+		actions = append(actions, action)
+	}
+
+	if len(actions) == 0 {
+		return nil
+	}
+
+	params := client.ModifyRouteRuleRequest{
+		RouteTableId: tableId,
+		RouteRule:    actions,
+	}
+	var resp client.ModifyRouteRuleResponse
+	return apiClient.Call(&params, &resp)
+}
+
+func describeRouteTable(apiClient *client.Client, tableId string) (*client.RouteTable, error) {
+	params := client.DescribeRouteTableRequest{
+		RouteTableId: tableId,
+	}
+
+	var resp client.DescribeRouteTableResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.RouteTables {
+		if resp.RouteTables[i].RouteTableId == tableId {
+			return &resp.RouteTables[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// routeRuleTypeCustom is the RuleType of the routes added by users, the
+// others are system routes of the VPC.
+const routeRuleTypeCustom = 1
+
+func flattenCustomRouteRules(table *client.RouteTable) []map[string]interface{} {
+	routes := make([]map[string]interface{}, 0, len(table.RouteRules))
+	for i := range table.RouteRules {
+		if table.RouteRules[i].RuleType == routeRuleTypeCustom {
+			routes = append(routes, flattenRouteRule(&table.RouteRules[i]))
+		}
+	}
+
+	return routes
+}
+
+func flattenRouteRule(rule *client.RouteRule) map[string]interface{} {
+	return map[string]interface{}{
+		"destination":  rule.DstAddr,
+		"nexthop_type": rule.NexthopType,
+		"nexthop_id":   rule.NexthopId,
+		"remark":       rule.Remark,
+	}
+}
+
+func findRouteRuleByDestination(table *client.RouteTable, destination string) *client.RouteRule {
+	for i := range table.RouteRules {
+		if table.RouteRules[i].DstAddr == destination {
+			return &table.RouteRules[i]
+		}
+	}
+
+	return nil
+}
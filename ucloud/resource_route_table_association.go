@@ -0,0 +1,153 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceRouteTableAssociation binds a subnet to a route table. A subnet is
+// always bound to some table, so deleting the association moves the subnet
+// back to the default route table of its VPC.
+func resourceRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteTableAssociationCreate,
+		Read:   resourceRouteTableAssociationRead,
+		Update: resourceRouteTableAssociationUpdate,
+		Delete: resourceRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	subnetId := d.Get("subnet_id").(string)
+	err := associateRouteTable(apiClient, subnetId, d.Get("route_table_id").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(subnetId)
+
+	return resourceRouteTableAssociationRead(d, meta)
+}
+
+func resourceRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	subnet, err := describeSubnet(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		log.Printf("[WARN] Subnet %s is gone, removing route table association from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", subnet.SubnetId)
+	d.Set("route_table_id", subnet.RouteTableId)
+
+	return nil
+}
+
+func resourceRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	if d.HasChange("route_table_id") {
+		err := associateRouteTable(apiClient, d.Id(), d.Get("route_table_id").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRouteTableAssociationRead(d, meta)
+}
+
+func resourceRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	subnet, err := describeSubnet(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		d.SetId("")
+		return nil
+	}
+
+	var resp client.DescribeRouteTableResponse
+	err = apiClient.Call(&client.DescribeRouteTableRequest{VPCId: subnet.VPCId}, &resp)
+	if err != nil {
+		return err
+	}
+
+	defaultTableId := ""
+	for _, table := range resp.RouteTables {
+		// 0 is the default route table, 1 is custom
+		if table.RouteTableType == 0 {
+			defaultTableId = table.RouteTableId
+		}
+	}
+	if defaultTableId == "" {
+		return fmt.Errorf("Cannot find the default route table of VPC %s", subnet.VPCId)
+	}
+
+	if subnet.RouteTableId != defaultTableId {
+		err = associateRouteTable(apiClient, d.Id(), defaultTableId)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func associateRouteTable(apiClient *client.Client, subnetId, tableId string) error {
+	params := client.AssociateRouteTableRequest{
+		SubnetId:     subnetId,
+		RouteTableId: tableId,
+	}
+	var resp client.AssociateRouteTableResponse
+	return apiClient.Call(&params, &resp)
+}
+
+func describeSubnet(apiClient *client.Client, subnetId string) (*client.Subnet, error) {
+	params := client.DescribeSubnetRequest{
+		SubnetIds: []string{subnetId},
+	}
+
+	var resp client.DescribeSubnetResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].SubnetId == subnetId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
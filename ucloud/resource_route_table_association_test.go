@@ -0,0 +1,56 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceRouteTableAssociation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_SUBNET_ID", "UCLOUD_UHOST_ID") },
+		IDRefreshName: "ucloud_route_table_association.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRouteTableConfig("") + fmt.Sprintf(testAccRouteTableAssociationConfig, os.Getenv("UCLOUD_SUBNET_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_route_table_association.foo", "subnet_id", os.Getenv("UCLOUD_SUBNET_ID")),
+					resource.TestCheckResourceAttrPair("ucloud_route_table_association.foo", "route_table_id", "ucloud_route_table.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRouteTableAssociationConfig = `
+resource "ucloud_route_table_association" "foo" {
+	subnet_id = "%s"
+	route_table_id = "${ucloud_route_table.foo.id}"
+}
+`
+
+func testAccCheckRouteTableAssociationDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_route_table_association" {
+			continue
+		}
+
+		subnet, err := describeSubnet(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if subnet != nil && subnet.RouteTableId == rs.Primary.Attributes["route_table_id"] {
+			return fmt.Errorf("Subnet %s is still associated with route table %s", subnet.SubnetId, subnet.RouteTableId)
+		}
+	}
+
+	return testAccCheckRouteTableDestroy(s)
+}
@@ -0,0 +1,141 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceRouteTable(t *testing.T) {
+	var table client.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_UHOST_ID") },
+		IDRefreshName: "ucloud_route_table.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRouteTableConfig(`
+	route {
+		destination = "10.100.0.0/16"
+		nexthop_type = "instance"
+		nexthop_id = "%[2]s"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("ucloud_route_table.foo", &table),
+					resource.TestCheckResourceAttr("ucloud_route_table.foo", "vpc_id", os.Getenv("UCLOUD_VPC_ID")),
+					resource.TestCheckResourceAttr("ucloud_route_table.foo", "route.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRouteTableConfig(`
+	route {
+		destination = "10.100.0.0/16"
+		nexthop_type = "instance"
+		nexthop_id = "%[2]s"
+		remark = "changed"
+	}
+	route {
+		destination = "10.101.0.0/16"
+		nexthop_type = "instance"
+		nexthop_id = "%[2]s"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("ucloud_route_table.foo", &table),
+					resource.TestCheckResourceAttr("ucloud_route_table.foo", "route.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ucloud_route_table.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccRouteTableConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("ucloud_route_table.foo", &table),
+					resource.TestCheckResourceAttr("ucloud_route_table.foo", "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRouteTableConfig returns a route table named foo with the given
+// routes, in which %[2]s is replaced with the next hop host id.
+func testAccRouteTableConfig(routes string) string {
+	return fmt.Sprintf(`
+resource "ucloud_route_table" "foo" {
+	vpc_id = "%[1]s"
+	remark = "foo"
+`+routes+`
+}
+`, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_UHOST_ID"))
+}
+
+func testAccCheckRouteTableDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_route_table" {
+			continue
+		}
+
+		table, err := describeRouteTable(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if table != nil {
+			return fmt.Errorf("Found undeleted route table: %+v", table)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRouteTableExists(n string, i *client.RouteTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		table, err := describeRouteTable(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if table == nil {
+			return fmt.Errorf("Route table not found")
+		}
+
+		*i = *table
+		return nil
+	}
+}
+
+func TestFlattenCustomRouteRules(t *testing.T) {
+	table := &client.RouteTable{
+		RouteRules: []client.RouteRule{
+			{RouteRuleId: "route-system", DstAddr: "10.9.0.0/16", NexthopType: "local", RuleType: 0},
+			{RouteRuleId: "route-foo", DstAddr: "10.100.0.0/16", NexthopType: "instance", NexthopId: "uhost-foo", RuleType: 1, Remark: "foo"},
+		},
+	}
+
+	routes := flattenCustomRouteRules(table)
+	if len(routes) != 1 {
+		t.Fatalf("Expect only the custom route but got: %v", routes)
+	}
+	if routes[0]["destination"] != "10.100.0.0/16" || routes[0]["nexthop_id"] != "uhost-foo" || routes[0]["remark"] != "foo" {
+		t.Errorf("Unexpected route: %v", routes[0])
+	}
+}
@@ -0,0 +1,184 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceShareBandwidth manages a bandwidth pool shared by the EIPs
+// attached with ucloud_share_bandwidth_attachment.
+func resourceShareBandwidth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceShareBandwidthCreate,
+		Read:   resourceShareBandwidthRead,
+		Update: resourceShareBandwidthUpdate,
+		Delete: resourceShareBandwidthDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(20, 5000),
+				Description:  "Shared bandwidth in Mbps",
+			},
+
+			"charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"quantity": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "Periods bought at create, not read back so changes are ignored afterwards",
+			},
+
+			"eip_bandwidth_on_release": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Bandwidth in Mbps given to each EIP still attached when this is deleted",
+			},
+
+			"eip_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceShareBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.AllocateShareBandwidthRequest{
+		Name:           d.Get("name").(string),
+		ChargeType:     d.Get("charge_type").(string),
+		ShareBandwidth: d.Get("bandwidth").(int),
+		Quantity:       d.Get("quantity").(int),
+	}
+
+	var resp client.AllocateShareBandwidthResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.ShareBandwidthId)
+
+	return resourceShareBandwidthRead(d, meta)
+}
+
+func resourceShareBandwidthRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	shareBandwidth, err := describeShareBandwidth(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if shareBandwidth == nil {
+		log.Printf("[WARN] Share bandwidth %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	eipIds := make([]string, 0, len(shareBandwidth.EIPSet))
+	for _, eip := range shareBandwidth.EIPSet {
+		eipIds = append(eipIds, eip.EIPId)
+	}
+
+	d.Set("name", shareBandwidth.Name)
+	d.Set("bandwidth", shareBandwidth.ShareBandwidth)
+	d.Set("charge_type", shareBandwidth.ChargeType)
+	d.Set("eip_ids", eipIds)
+	d.Set("create_time", shareBandwidth.CreateTime)
+	d.Set("expire_time", shareBandwidth.ExpireTime)
+
+	return nil
+}
+
+func resourceShareBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	// eip_bandwidth_on_release is only used on delete
+	if d.HasChange("bandwidth") {
+		params := client.ResizeShareBandwidthRequest{
+			ShareBandwidthId: d.Id(),
+			ShareBandwidth:   d.Get("bandwidth").(int),
+		}
+		var resp client.ResizeShareBandwidthResponse
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceShareBandwidthRead(d, meta)
+}
+
+func resourceShareBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.ReleaseShareBandwidthRequest{
+		ShareBandwidthId: d.Id(),
+		EIPBandwidth:     d.Get("eip_bandwidth_on_release").(int),
+		PayMode:          "Bandwidth",
+	}
+	var resp client.ReleaseShareBandwidthResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeShareBandwidth(apiClient *client.Client, shareBandwidthId string) (*client.ShareBandwidth, error) {
+	params := client.DescribeShareBandwidthRequest{
+		ShareBandwidthIds: []string{shareBandwidthId},
+	}
+
+	var resp client.DescribeShareBandwidthResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].ShareBandwidthId == shareBandwidthId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceShareBandwidthAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceShareBandwidthAttachmentCreate,
+		Read:   resourceShareBandwidthAttachmentRead,
+		Update: resourceShareBandwidthAttachmentUpdate,
+		Delete: resourceShareBandwidthAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"share_bandwidth_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eip_bandwidth_on_detach": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Bandwidth in Mbps given to the EIP when it is detached",
+			},
+		},
+	}
+}
+
+func resourceShareBandwidthAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	shareBandwidthId := d.Get("share_bandwidth_id").(string)
+	eipId := d.Get("eip_id").(string)
+	params := client.AssociateEIPWithShareBandwidthRequest{
+		ShareBandwidthId: shareBandwidthId,
+		EIPIds:           []string{eipId},
+	}
+
+	var resp client.AssociateEIPWithShareBandwidthResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(shareBandwidthId, eipId))
+
+	return resourceShareBandwidthAttachmentRead(d, meta)
+}
+
+func resourceShareBandwidthAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	parts, err := parseCompositeId(d.Id(), "share_bandwidth_id", "eip_id")
+	if err != nil {
+		return err
+	}
+	shareBandwidthId, eipId := parts[0], parts[1]
+
+	shareBandwidth, err := describeShareBandwidth(apiClient, shareBandwidthId)
+	if err != nil {
+		return err
+	}
+	attached := false
+	if shareBandwidth != nil {
+		for _, eip := range shareBandwidth.EIPSet {
+			if eip.EIPId == eipId {
+				attached = true
+			}
+		}
+	}
+	if !attached {
+		log.Printf("[WARN] Share bandwidth attachment %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("share_bandwidth_id", shareBandwidthId)
+	d.Set("eip_id", eipId)
+
+	return nil
+}
+
+func resourceShareBandwidthAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// eip_bandwidth_on_detach is only used on delete
+	return resourceShareBandwidthAttachmentRead(d, meta)
+}
+
+func resourceShareBandwidthAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DisassociateEIPWithShareBandwidthRequest{
+		ShareBandwidthId: d.Get("share_bandwidth_id").(string),
+		EIPIds:           []string{d.Get("eip_id").(string)},
+		Bandwidth:        d.Get("eip_bandwidth_on_detach").(int),
+		PayMode:          "Bandwidth",
+	}
+	var resp client.DisassociateEIPWithShareBandwidthResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceShareBandwidthAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_EIP_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckShareBandwidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccShareBandwidthConfig, 20) + fmt.Sprintf(testAccShareBandwidthAttachmentConfig, os.Getenv("UCLOUD_EIP_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth_attachment.foo", "eip_id", os.Getenv("UCLOUD_EIP_ID")),
+					resource.TestCheckResourceAttrPair("ucloud_share_bandwidth_attachment.foo", "share_bandwidth_id", "ucloud_share_bandwidth.foo", "id"),
+				),
+			},
+			// eip_ids of the share bandwidth is read before the attachment
+			// is created, so it shows up on the next refresh
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccShareBandwidthConfig, 20) + fmt.Sprintf(testAccShareBandwidthAttachmentConfig, os.Getenv("UCLOUD_EIP_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "eip_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccShareBandwidthAttachmentConfig = `
+resource "ucloud_share_bandwidth_attachment" "foo" {
+	share_bandwidth_id = "${ucloud_share_bandwidth.foo.id}"
+	eip_id = "%s"
+	eip_bandwidth_on_detach = 2
+}
+`
@@ -0,0 +1,62 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceShareBandwidth(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_share_bandwidth.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckShareBandwidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccShareBandwidthConfig, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "name", "foo"),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "bandwidth", "20"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccShareBandwidthConfig, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "bandwidth", "30"),
+				),
+			},
+		},
+	})
+}
+
+const testAccShareBandwidthConfig = `
+resource "ucloud_share_bandwidth" "foo" {
+	name = "foo"
+	bandwidth = %d
+	charge_type = "Dynamic"
+}
+`
+
+func testAccCheckShareBandwidthDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_share_bandwidth" {
+			continue
+		}
+
+		shareBandwidth, err := describeShareBandwidth(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if shareBandwidth != nil {
+			return fmt.Errorf("Found undeleted share bandwidth: %+v", shareBandwidth)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceUFileBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUFileBucketCreate,
+		Read:   resourceUFileBucketRead,
+		Update: resourceUFileBucketUpdate,
+		Delete: resourceUFileBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validateAllowedStringValue([]string{"private", "public"}),
+			},
+
+			"src_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"cdn_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUFileBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateBucketRequest{
+		BucketName: d.Get("name").(string),
+		Type:       d.Get("type").(string),
+	}
+
+	var resp client.CreateBucketResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.BucketName)
+
+	return resourceUFileBucketRead(d, meta)
+}
+
+func resourceUFileBucketRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	bucket, err := describeBucket(apiClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if bucket == nil {
+		log.Printf("[WARN] UFile bucket %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", bucket.BucketName)
+	d.Set("type", bucket.Type)
+	d.Set("src_domains", bucket.Domain.Src)
+	d.Set("cdn_domains", bucket.Domain.Cdn)
+	d.Set("create_time", bucket.CreateTime)
+
+	return nil
+}
+
+func resourceUFileBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.UpdateBucketRequest{
+		BucketName: d.Id(),
+		Type:       d.Get("type").(string),
+	}
+	var resp client.UpdateBucketResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resourceUFileBucketRead(d, meta)
+}
+
+func resourceUFileBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.DeleteBucketRequest{BucketName: d.Id()}
+	var resp client.DeleteBucketResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func describeBucket(apiClient *client.Client, name string) (*client.UFileBucket, error) {
+	params := client.DescribeBucketRequest{
+		BucketName: name,
+	}
+
+	var resp client.DescribeBucketResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].BucketName == name {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
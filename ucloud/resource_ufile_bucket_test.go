@@ -0,0 +1,65 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceUFileBucket(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_ufile_bucket.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckUFileBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUFileBucketConfig, name, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_ufile_bucket.foo", "name", name),
+					resource.TestCheckResourceAttr("ucloud_ufile_bucket.foo", "type", "private"),
+					resource.TestCheckResourceAttrSet("ucloud_ufile_bucket.foo", "src_domains.0"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUFileBucketConfig, name, "public"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_ufile_bucket.foo", "type", "public"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUFileBucketConfig = `
+resource "ucloud_ufile_bucket" "foo" {
+	name = "%s"
+	type = "%s"
+}
+`
+
+func testAccCheckUFileBucketDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_ufile_bucket" {
+			continue
+		}
+
+		bucket, err := describeBucket(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if bucket != nil {
+			return fmt.Errorf("Found undeleted UFile bucket: %+v", bucket)
+		}
+	}
+
+	return nil
+}
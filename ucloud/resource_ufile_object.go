@@ -0,0 +1,168 @@
+package ucloud
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceUFileObject uploads a file to a UFile bucket from either a local
+// source file or inline content. content_hash is the MD5 of the local
+// content, which is recomputed on every plan so that editing the source file
+// uploads it again. When the remote ETag no longer matches the uploaded one,
+// the file was overwritten outside of terraform and content_hash is cleared
+// to upload it again.
+func resourceUFileObject() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceUFileObjectCreate,
+		Read:          resourceUFileObjectRead,
+		Update:        resourceUFileObjectUpdate,
+		Delete:        resourceUFileObjectDelete,
+		CustomizeDiff: resourceUFileObjectCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+				Description:   "Path of the local file to upload",
+			},
+
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "application/octet-stream",
+			},
+
+			"content_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUFileObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	err := putUFileObject(d, meta.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(d.Get("bucket").(string), d.Get("key").(string)))
+
+	return resourceUFileObjectRead(d, meta)
+}
+
+func resourceUFileObjectRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	object, err := apiClient.HeadFile(d.Get("bucket").(string), d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		log.Printf("[WARN] UFile object %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if object.ETag != d.Get("etag").(string) {
+		log.Printf("[WARN] UFile object %s has been changed, uploading it again", d.Id())
+		d.Set("content_hash", "")
+	}
+	d.Set("content_type", object.ContentType)
+
+	return nil
+}
+
+func resourceUFileObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := putUFileObject(d, meta.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	return resourceUFileObjectRead(d, meta)
+}
+
+func resourceUFileObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	err := apiClient.DeleteFile(d.Get("bucket").(string), d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceUFileObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	body, err := ufileObjectBody(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	if hash := fmt.Sprintf("%x", md5.Sum(body)); hash != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
+
+func putUFileObject(d *schema.ResourceData, c *client.Client) error {
+	body, err := ufileObjectBody(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	etag, err := c.PutFile(d.Get("bucket").(string), d.Get("key").(string), d.Get("content_type").(string), body)
+	if err != nil {
+		return err
+	}
+
+	d.Set("content_hash", fmt.Sprintf("%x", md5.Sum(body)))
+	d.Set("etag", etag)
+
+	return nil
+}
+
+func ufileObjectBody(source, content string) ([]byte, error) {
+	if source == "" {
+		return []byte(content), nil
+	}
+
+	body, err := ioutil.ReadFile(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s: %s", source, err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,108 @@
+package ucloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client/ufiletest"
+)
+
+// TestAccResourceUFileObject runs against a fake UFile server, so it only
+// needs TF_ACC but no credentials.
+func TestAccResourceUFileObject(t *testing.T) {
+	hs := ufiletest.NewServer("public", "private")
+	defer hs.Close()
+
+	providers := map[string]terraform.ResourceProvider{
+		"ucloud": ProviderWithConfig(&client.Config{
+			PublicKey:     "public",
+			PrivateKey:    "private",
+			Region:        "cn-bj2",
+			UFileEndpoint: hs.Endpoint(),
+		}).(*schema.Provider),
+	}
+
+	dir, err := ioutil.TempDir("", "tf-ufile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "index.html")
+
+	writeSource := func(content string) func() {
+		return func() {
+			if err := ioutil.WriteFile(source, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    providers,
+		CheckDestroy: testAccCheckUFileObjectDestroy(hs),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				PreConfig: writeSource("<h1>foo</h1>"),
+				Config:    fmt.Sprintf(testAccUFileObjectConfig, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUFileObjectContent(hs, "<h1>foo</h1>"),
+					resource.TestCheckResourceAttr("ucloud_ufile_object.foo", "content_type", "text/html"),
+					resource.TestCheckResourceAttrSet("ucloud_ufile_object.foo", "etag"),
+				),
+			},
+			// editing the local file uploads it again
+			resource.TestStep{
+				PreConfig: writeSource("<h1>bar</h1>"),
+				Config:    fmt.Sprintf(testAccUFileObjectConfig, source),
+				Check:     testAccCheckUFileObjectContent(hs, "<h1>bar</h1>"),
+			},
+			// so does overwriting the remote file
+			resource.TestStep{
+				PreConfig: func() { hs.PutFile("assets", "www/index.html", "text/html", []byte("defaced")) },
+				Config:    fmt.Sprintf(testAccUFileObjectConfig, source),
+				Check:     testAccCheckUFileObjectContent(hs, "<h1>bar</h1>"),
+			},
+		},
+	})
+}
+
+const testAccUFileObjectConfig = `
+resource "ucloud_ufile_object" "foo" {
+	bucket = "assets"
+	key = "www/index.html"
+	source = "%s"
+	content_type = "text/html"
+}
+`
+
+func testAccCheckUFileObjectContent(hs *ufiletest.Server, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		body, ok := hs.File("assets", "www/index.html")
+		if !ok {
+			return fmt.Errorf("UFile object not found")
+		}
+		if string(body) != expected {
+			return fmt.Errorf("Expected UFile object content %q, got %q", expected, body)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUFileObjectDestroy(hs *ufiletest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := hs.File("assets", "www/index.html"); ok {
+			return fmt.Errorf("Found undeleted UFile object")
+		}
+
+		return nil
+	}
+}
@@ -1,9 +1,12 @@
 package ucloud
 
 import (
+	"crypto/sha1"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
@@ -21,6 +24,12 @@ func resourceUHost() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceUHostCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -30,9 +39,37 @@ func resourceUHost() *schema.Resource {
 			},
 
 			"image_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Changing it replaces the host unless reinstall_on_image_change is set",
+			},
+
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"running", "stopped"}),
+			},
+
+			"allow_stopping_for_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether a resize which cannot be done online may stop the host, set it to false to fail the plan instead",
+			},
+
+			"reinstall_on_image_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reinstall the host in place when image_id changes, keeping its id and IPs",
+			},
+
+			"reinstall_reserve_data_disk": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the data disk survives a reinstall",
 			},
 
 			"password": {
@@ -55,9 +92,67 @@ func resourceUHost() *schema.Resource {
 			},
 
 			"disk_space": {
-				Type:     schema.TypeInt,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"boot_disk", "data_disk"},
+			},
+
+			"storage_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"boot_disk", "data_disk"},
+			},
+
+			"boot_disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAllowedStringValue(uhostDiskTypes),
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"data_disk": {
+				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAllowedStringValue(uhostDiskTypes),
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"disk_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 
 			"name": {
@@ -79,18 +174,31 @@ func resourceUHost() *schema.Resource {
 			},
 
 			"charge_type": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Year", "Month", "Dynamic", "Trial"}),
+				Description:  "计费模式，枚举值为： Year，按年付费； Month，按月付费； Dynamic，按需付费（需开启权限）； Trial，试用（需开启权限） 默认为月付",
+			},
+
+			"quantity": {
+				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
-				Description: "计费模式，枚举值为： Year，按年付费； Month，按月付费； Dynamic，按需付费（需开启权限）； Trial，试用（需开启权限） 默认为月付",
+				Description: "Periods bought at create and when charge_type changes, use ucloud_uhost_renewal to renew the host",
 			},
 
-			"quantity": {
-				Type:     schema.TypeInt,
+			"auto_renew": {
+				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
 
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"net_capability": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -104,9 +212,10 @@ func resourceUHost() *schema.Resource {
 			},
 
 			"boot_disk_space": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"boot_disk", "data_disk"},
 			},
 
 			"uhost_type": {
@@ -115,6 +224,34 @@ func resourceUHost() *schema.Resource {
 				Computed: true,
 			},
 
+			"isolation_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of a ucloud_isolation_group, hosts in the same group never share a physical host",
+			},
+
+			"user_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data_base64"},
+				StateFunc:     userDataHashSum,
+				ValidateFunc:  validateUserData,
+				Description:   "Cloud-init user data, only its SHA-1 is kept in state",
+			},
+
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_data"},
+				StateFunc:     userDataHashSum,
+				ValidateFunc:  validateUserDataBase64,
+				Description:   "Base64 encoded user data for binary content such as gzip, only its SHA-1 is kept in state",
+			},
+
 			"basic_image_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -232,11 +369,23 @@ func resourceUHostCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("boot_disk_space"); ok {
 		params.BootDiskSpace = v.(int)
 	}
-
-	log.Printf("[DEBUG] Run configuration: %s", params)
+	if v, ok := d.GetOk("isolation_group"); ok {
+		params.IsolationGroup = v.(string)
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		params.UserData = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	if v, ok := d.GetOk("user_data_base64"); ok {
+		params.UserData = v.(string)
+	}
+	disks, err := expandUHostDisks(d)
+	if err != nil {
+		return err
+	}
+	params.Disks = disks
 
 	var resp client.CreateUHostInstanceResponse
-	err := apiClient.Call(&params, &resp)
+	err = apiClient.Call(&params, &resp)
 	if err != nil {
 		return err
 	}
@@ -246,14 +395,12 @@ func resourceUHostCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Waiting for instance (%s) to become running", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"Initializing", "Starting"},
-		Target:     []string{"Running"},
-		Refresh:    instanceRefreshFunc(apiClient, id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	stateConf := newStateChangeConf(
+		[]string{"Initializing", "Starting"},
+		[]string{"Running"},
+		instanceRefreshFunc(apiClient, id),
+		d.Timeout(schema.TimeoutCreate),
+	)
 
 	instance, err := stateConf.WaitForState()
 	if err != nil {
@@ -300,6 +447,46 @@ func resourceUHostUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 	var resp client.GeneralResponse
 
+	// image_id, only changes in place with reinstall_on_image_change
+	if d.HasChange("image_id") && !d.IsNewResource() {
+		err := reinstallUHostInstance(apiClient, d)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("image_id")
+	}
+
+	// charge_type, changes from or to Trial replace the host instead
+	if d.HasChange("charge_type") && !d.IsNewResource() {
+		params := client.ModifyResourceChargeTypeRequest{
+			ResourceId: d.Id(),
+			ChargeType: d.Get("charge_type").(string),
+			Quantity:   d.Get("quantity").(int),
+		}
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return fmt.Errorf("Error changing charge type of instance %s: %s", d.Id(), err)
+		}
+		d.SetPartial("charge_type")
+		d.SetPartial("quantity")
+	}
+
+	// auto_renew
+	if d.HasChange("auto_renew") {
+		params := client.ModifyAutoRenewFlagRequest{
+			ResourceId: d.Id(),
+			Flag:       "No",
+		}
+		if d.Get("auto_renew").(bool) {
+			params.Flag = "Yes"
+		}
+		err := apiClient.Call(&params, &resp)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("auto_renew")
+	}
+
 	// name
 	if d.HasChange("name") && !d.IsNewResource() {
 		params := &client.ModifyUHostInstanceNameRequest{
@@ -339,41 +526,166 @@ func resourceUHostUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("remark")
 	}
 
-	// reize: has to restart the host
-	if !d.IsNewResource() && (d.HasChange("cpu") || d.HasChange("memory") || d.HasChange("disk_space")) {
-		err := stopUHostInstance(apiClient, d.Id())
+	// resize: stops the host unless it can be resized online, power_state
+	// below starts it again
+	if !d.IsNewResource() && hasUHostResizeChange(d) {
+		instance, err := describeInstance(apiClient, d.Id())
 		if err != nil {
 			return err
 		}
+		if instance == nil {
+			return fmt.Errorf("Instance %s not found", d.Id())
+		}
 
-		params := client.ResizeUHostInstanceRequest{
-			UHostId: d.Id(),
+		needsStop := uhostResizeNeedsStop(instance, d)
+		if needsStop {
+			if !d.Get("allow_stopping_for_update").(bool) && d.Get("power_state").(string) != "stopped" {
+				return fmt.Errorf("Resizing instance %s requires stopping it, set allow_stopping_for_update to allow it", d.Id())
+			}
+			err = stopUHostInstance(apiClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
 		}
-		if d.HasChange("cpu") {
-			params.CPU = d.Get("cpu").(int)
+
+		if d.HasChange("cpu") || d.HasChange("memory") || d.HasChange("disk_space") {
+			params := client.ResizeUHostInstanceRequest{
+				UHostId: d.Id(),
+			}
+			if d.HasChange("cpu") {
+				params.CPU = d.Get("cpu").(int)
+			}
+			if d.HasChange("memory") {
+				params.Memory = d.Get("memory").(int)
+			}
+			if d.HasChange("disk_space") {
+				params.DiskSpace = d.Get("disk_space").(int)
+			}
+			err = apiClient.Call(&params, &resp)
+			if err != nil {
+				return err
+			}
+
+			if !needsStop && instance.State == "Running" {
+				err = waitForUHostHotResize(apiClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return err
+				}
+			}
 		}
-		if d.HasChange("memory") {
-			params.Memory = d.Get("memory").(int)
+
+		if d.HasChange("boot_disk.0.size") {
+			err = resizeAttachedDisk(apiClient, d, "boot_disk.0")
+			if err != nil {
+				return err
+			}
 		}
-		if d.HasChange("disk_space") {
-			params.DiskSpace = d.Get("disk_space").(int)
+		for i := range d.Get("data_disk").([]interface{}) {
+			prefix := fmt.Sprintf("data_disk.%d", i)
+			if d.HasChange(prefix + ".size") {
+				err = resizeAttachedDisk(apiClient, d, prefix)
+				if err != nil {
+					return err
+				}
+			}
 		}
-		err = apiClient.Call(&params, &resp)
-		if err != nil {
-			return err
+
+		d.SetPartial("resize")
+	}
+
+	// power_state
+	err := updateUHostPowerState(apiClient, d)
+	if err != nil {
+		return err
+	}
+	d.SetPartial("power_state")
+
+	d.Partial(false)
+
+	return resourceUHostRead(d, meta)
+}
+
+func resourceUHostCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	err := validateUHostInstanceTypeDiff(d, meta.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("image_id") && !d.Get("reinstall_on_image_change").(bool) {
+		return d.ForceNew("image_id")
+	}
+
+	if d.HasChange("charge_type") {
+		o, n := d.GetChange("charge_type")
+		if o.(string) == "Trial" || n.(string) == "Trial" {
+			return d.ForceNew("charge_type")
 		}
+	} else if o, _ := d.GetChange("quantity"); d.HasChange("quantity") && o.(int) != 0 {
+		// quantity is not read back, so imported hosts simply take the
+		// configured one
+		return fmt.Errorf("quantity of instance %s only takes effect when charge_type changes, use ucloud_uhost_renewal to renew it", d.Id())
+	}
 
-		err = startUHostInstance(apiClient, d.Id())
+	// fail at plan time rather than stopping a host which is meant to run
+	if hasUHostResizeChange(d) && !d.Get("allow_stopping_for_update").(bool) && d.Get("power_state").(string) != "stopped" {
+		instance, err := describeInstance(meta.(*client.Client), d.Id())
 		if err != nil {
 			return err
 		}
+		if instance != nil && uhostResizeNeedsStop(instance, d) {
+			return fmt.Errorf("Resizing instance %s requires stopping it, set allow_stopping_for_update to allow it", d.Id())
+		}
+	}
 
-		d.SetPartial("resize")
+	return nil
+}
+
+// uhostChanges is implemented by both *schema.ResourceData and
+// *schema.ResourceDiff.
+type uhostChanges interface {
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+	HasChange(string) bool
+}
+
+func hasUHostResizeChange(d uhostChanges) bool {
+	return d.HasChange("cpu") || d.HasChange("memory") || d.HasChange("disk_space") || d.HasChange("boot_disk") || d.HasChange("data_disk")
+}
+
+// uhostResizeNeedsStop tells whether the pending resize has to stop the
+// host. CPU and memory can only grow online on hosts with HotplugFeature,
+// and cloud data disks can grow online. Local disks and the boot disk need
+// the host stopped.
+func uhostResizeNeedsStop(instance *client.UHostInstance, d uhostChanges) bool {
+	if instance.State == "Stopped" {
+		return false
 	}
 
-	d.Partial(false)
+	for _, key := range []string{"cpu", "memory"} {
+		if d.HasChange(key) {
+			o, n := d.GetChange(key)
+			if !instance.HotplugFeature || n.(int) < o.(int) {
+				return true
+			}
+		}
+	}
 
-	return resourceUHostRead(d, meta)
+	if d.HasChange("disk_space") || d.HasChange("boot_disk.0.size") {
+		return true
+	}
+
+	for i := range d.Get("data_disk").([]interface{}) {
+		prefix := fmt.Sprintf("data_disk.%d", i)
+		if d.HasChange(prefix+".size") && !strings.HasPrefix(d.Get(prefix+".type").(string), "CLOUD_") {
+			return true
+		}
+	}
+
+	return false
 }
 
 func resourceUHostDelete(d *schema.ResourceData, meta interface{}) error {
@@ -389,7 +701,7 @@ func resourceUHostDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if host.State != "Stopped" && host.State != "Install Failed" {
-		err = stopUHostInstance(apiClient, d.Id())
+		err = stopUHostInstance(apiClient, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -483,6 +795,120 @@ func readDiskSet(instance *client.UHostInstance) []map[string]interface{} {
 	return diskSet
 }
 
+var uhostDiskTypes = []string{"LOCAL_NORMAL", "LOCAL_SSD", "CLOUD_NORMAL", "CLOUD_SSD"}
+
+// expandUHostDisks turns boot_disk and data_disk into Disks.N, which is
+// empty when the legacy disk_space arguments are used instead.
+func expandUHostDisks(d *schema.ResourceData) ([]client.CreateUHostInstanceDisk, error) {
+	v, ok := d.GetOk("boot_disk")
+	if !ok {
+		if _, ok := d.GetOk("data_disk"); ok {
+			return nil, fmt.Errorf("boot_disk is required with data_disk")
+		}
+		return nil, nil
+	}
+
+	bootDisk := v.([]interface{})[0].(map[string]interface{})
+	disks := []client.CreateUHostInstanceDisk{
+		{
+			IsBoot: "True",
+			Type:   bootDisk["type"].(string),
+			Size:   bootDisk["size"].(int),
+		},
+	}
+
+	for _, v := range d.Get("data_disk").([]interface{}) {
+		dataDisk := v.(map[string]interface{})
+		disks = append(disks, client.CreateUHostInstanceDisk{
+			IsBoot: "False",
+			Type:   dataDisk["type"].(string),
+			Size:   dataDisk["size"].(int),
+		})
+	}
+
+	return disks, nil
+}
+
+func isBootDisk(disk client.UHostDisk) bool {
+	return disk.IsBoot == "True" || disk.Type == "Boot"
+}
+
+func flattenUHostDisk(disk client.UHostDisk) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    disk.DiskType,
+		"size":    disk.Size,
+		"disk_id": disk.DiskId,
+	}
+}
+
+func readBootDisk(instance *client.UHostInstance) []map[string]interface{} {
+	for _, disk := range instance.DiskSet {
+		if isBootDisk(disk) {
+			return []map[string]interface{}{flattenUHostDisk(disk)}
+		}
+	}
+
+	return nil
+}
+
+// readDataDisks matches the data disks of instance to the configured
+// data_disk blocks, by disk_id first and then in DiskSet order, which is the
+// order they were created in. Disks left over, such as UDisks attached
+// separately, are only reported when no data_disk is configured.
+func readDataDisks(configured []interface{}, instance *client.UHostInstance) []map[string]interface{} {
+	var dataDisks []client.UHostDisk
+	for _, disk := range instance.DiskSet {
+		if !isBootDisk(disk) {
+			dataDisks = append(dataDisks, disk)
+		}
+	}
+
+	ret := make([]map[string]interface{}, 0, len(dataDisks))
+	if len(configured) == 0 {
+		for _, disk := range dataDisks {
+			ret = append(ret, flattenUHostDisk(disk))
+		}
+		return ret
+	}
+
+	used := make([]bool, len(dataDisks))
+	matched := make([]int, len(configured))
+	for i, v := range configured {
+		matched[i] = -1
+		block, ok := v.(map[string]interface{})
+		if !ok || block["disk_id"] == nil || block["disk_id"].(string) == "" {
+			continue
+		}
+		for j, disk := range dataDisks {
+			if !used[j] && disk.DiskId == block["disk_id"].(string) {
+				matched[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	for i := range configured {
+		if matched[i] != -1 {
+			continue
+		}
+		for j := range dataDisks {
+			if !used[j] {
+				matched[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	for _, j := range matched {
+		if j != -1 {
+			ret = append(ret, flattenUHostDisk(dataDisks[j]))
+		}
+	}
+
+	return ret
+}
+
 func readIPSet(instance *client.UHostInstance) interface{} {
 	ipSet := make([]map[string]interface{}, 0, len(instance.IPSet))
 
@@ -507,35 +933,173 @@ func setResourceDataFromInstance(d *schema.ResourceData, instance *client.UHostI
 	d.Set("remark", instance.Remark)
 	d.Set("name", instance.Name)
 	d.Set("charge_type", instance.ChargeType)
+	d.Set("auto_renew", instance.AutoRenew == "Yes")
+	d.Set("expire_time", instance.ExpireTime)
 	d.Set("cpu", instance.CPU)
 	d.Set("memory", instance.Memory)
 	d.Set("disk_set", readDiskSet(instance))
+	d.Set("boot_disk", readBootDisk(instance))
+	d.Set("data_disk", readDataDisks(d.Get("data_disk").([]interface{}), instance))
 	d.Set("ip_set", readIPSet(instance))
 	d.Set("net_capability", instance.NetCapability)
+	d.Set("isolation_group", instance.IsolationGroup)
+	switch instance.State {
+	case "Running":
+		d.Set("power_state", "running")
+	case "Stopped":
+		d.Set("power_state", "stopped")
+	}
+}
+
+// userDataHashSum keeps user data, which may hold secrets, out of state.
+func userDataHashSum(v interface{}) string {
+	hash := sha1.Sum([]byte(v.(string)))
+	return hex.EncodeToString(hash[:])
+}
+
+// resizeAttachedDisk resizes the boot_disk or data_disk block at prefix.
+// Callers stop the host first when uhostResizeNeedsStop.
+func resizeAttachedDisk(c *client.Client, d *schema.ResourceData, prefix string) error {
+	params := client.ResizeAttachedDiskRequest{
+		UHostId:   d.Id(),
+		Zone:      d.Get("zone").(string),
+		DiskId:    d.Get(prefix + ".disk_id").(string),
+		DiskSpace: d.Get(prefix + ".size").(int),
+	}
+	var resp client.ResizeAttachedDiskResponse
+	err := c.Call(&params, &resp)
+	if err != nil {
+		return fmt.Errorf("Error resizing disk %s of instance %s: %s", params.DiskId, d.Id(), err)
+	}
+
+	return nil
+}
+
+// reinstallUHostInstance installs image_id on the stopped host and starts
+// it again.
+func reinstallUHostInstance(c *client.Client, d *schema.ResourceData) error {
+	id := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err := ensureUHostInstanceStopped(c, id, timeout)
+	if err != nil {
+		return err
+	}
+
+	params := client.ReinstallUHostInstanceRequest{
+		UHostId:     id,
+		Zone:        d.Get("zone").(string),
+		ImageId:     d.Get("image_id").(string),
+		Password:    base64.StdEncoding.EncodeToString([]byte(d.Get("password").(string))),
+		ReserveDisk: "No",
+	}
+	if d.Get("reinstall_reserve_data_disk").(bool) {
+		params.ReserveDisk = "Yes"
+	}
+	var resp client.ReinstallUHostInstanceResponse
+	err = c.Call(&params, &resp)
+	if err != nil {
+		return fmt.Errorf("Error reinstalling instance %s: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to be reinstalled", id)
+
+	stateConf := newStateChangeConf(
+		[]string{"Install", "Installing", "Rebuilding", "Starting"},
+		[]string{"Running", "Stopped"},
+		instanceRefreshFunc(c, id),
+		timeout,
+	)
+	instance, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to be reinstalled: %s", id, err)
+	}
+
+	// the host may still be stopped until the install task is picked up,
+	// startUHostInstance retries until then
+	if instance.(*client.UHostInstance).State == "Stopped" {
+		return startUHostInstance(c, id, timeout)
+	}
+
+	return nil
+}
+
+// updateUHostPowerState stops or starts the host to match power_state, which
+// is running unless set otherwise.
+func updateUHostPowerState(c *client.Client, d *schema.ResourceData) error {
+	host, err := describeInstance(c, d.Id())
+	if err != nil {
+		return err
+	}
+	if host == nil {
+		return fmt.Errorf("Instance %s not found", d.Id())
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	stopped := d.Get("power_state").(string) == "stopped"
+	if stopped && host.State != "Stopped" {
+		return stopUHostInstance(c, d.Id(), timeout)
+	}
+	if !stopped && host.State == "Stopped" {
+		return startUHostInstance(c, d.Id(), timeout)
+	}
+
+	return nil
+}
+
+func waitForUHostHotResize(c *client.Client, id string, timeout time.Duration) error {
+	stateConf := newStateChangeConf(
+		[]string{"Resizing", "Rebooting"},
+		[]string{"Running"},
+		instanceRefreshFunc(c, id),
+		timeout,
+	)
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to be resized: %s", id, err)
+	}
+
+	return nil
 }
 
-func stopUHostInstance(c *client.Client, id string) error {
+func ensureUHostInstanceStopped(c *client.Client, id string, timeout time.Duration) error {
+	host, err := describeInstance(c, id)
+	if err != nil {
+		return err
+	}
+	if host == nil {
+		return fmt.Errorf("Instance %s not found", id)
+	}
+	if host.State == "Stopped" {
+		return nil
+	}
+
+	return stopUHostInstance(c, id, timeout)
+}
+
+func stopUHostInstance(c *client.Client, id string, timeout time.Duration) error {
 	var resp client.GeneralResponse
 	err := c.Call(&client.StopUHostInstanceRequest{UHostId: id}, &resp)
 	if err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"Running", "Stopping", "Rebooting"},
-		Target:     []string{"Stopped"},
-		Refresh:    instanceRefreshFunc(c, id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	stateConf := newStateChangeConf(
+		[]string{"Running", "Stopping", "Rebooting"},
+		[]string{"Stopped"},
+		instanceRefreshFunc(c, id),
+		timeout,
+	)
 	_, err = stateConf.WaitForState()
 	return err
 }
 
-func startUHostInstance(c *client.Client, id string) error {
+func startUHostInstance(c *client.Client, id string, timeout time.Duration) error {
 	var resp client.GeneralResponse
-	err := resource.Retry(60*time.Second, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		err := c.Call(&client.StartUHostInstanceRequest{UHostId: id}, &resp)
 		if err != nil {
 			if brce, ok := err.(*client.BadRetCodeError); ok && brce.RetCode == 8903 { // uhost in task error
@@ -550,14 +1114,12 @@ func startUHostInstance(c *client.Client, id string) error {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"Stopped", "Stopping", "Rebooting"},
-		Target:     []string{"Running"},
-		Refresh:    instanceRefreshFunc(c, id),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	stateConf := newStateChangeConf(
+		[]string{"Stopped", "Stopping", "Rebooting"},
+		[]string{"Running"},
+		instanceRefreshFunc(c, id),
+		timeout,
+	)
 	_, err = stateConf.WaitForState()
 	return err
 }
@@ -0,0 +1,139 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceUHostRenewal renews a Year or Month host for quantity periods when
+// created. A renewal cannot be undone, so deleting it only removes it from
+// state, and a new one is bought whenever uhost_id or quantity changes.
+func resourceUHostRenewal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUHostRenewalCreate,
+		Read:   resourceUHostRenewalRead,
+		Delete: resourceUHostRenewalDelete,
+
+		CustomizeDiff: resourceUHostRenewalCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"uhost_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"quantity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ForceNew:     true,
+				ValidateFunc: validateIntBetween(1, 12),
+				Description:  "Periods of the charge type of the host to renew for",
+			},
+
+			"expire_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUHostRenewalCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	uhostId := d.Get("uhost_id").(string)
+	instance, err := describeInstance(apiClient, uhostId)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("Instance %s not found", uhostId)
+	}
+	// uhost_id may have been unknown at plan time
+	err = validateUHostRenewable(instance)
+	if err != nil {
+		return err
+	}
+
+	params := client.RenewResourceRequest{
+		ResourceId: uhostId,
+		Quantity:   d.Get("quantity").(int),
+	}
+
+	var resp client.RenewResourceResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return fmt.Errorf("Error renewing instance %s: %s", uhostId, err)
+	}
+
+	instance, err = describeInstance(apiClient, uhostId)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("Instance %s not found", uhostId)
+	}
+
+	// several renewals of a host differ in the expire time they lead to
+	d.SetId(buildCompositeId(uhostId, strconv.Itoa(instance.ExpireTime)))
+	d.Set("expire_time", instance.ExpireTime)
+
+	return resourceUHostRenewalRead(d, meta)
+}
+
+// resourceUHostRenewalCustomizeDiff rejects hosts which cannot be renewed,
+// when uhost_id is known at plan time.
+func resourceUHostRenewalCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("uhost_id") {
+		return nil
+	}
+
+	uhostId := d.Get("uhost_id").(string)
+	instance, err := describeInstance(meta.(*client.Client), uhostId)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("Instance %s not found", uhostId)
+	}
+
+	return validateUHostRenewable(instance)
+}
+
+// validateUHostRenewable checks that instance is charged by Year or Month,
+// the charge types which have periods to renew.
+func validateUHostRenewable(instance *client.UHostInstance) error {
+	if instance.ChargeType != "Year" && instance.ChargeType != "Month" {
+		return fmt.Errorf("Instance %s is charged by %s, only Year and Month instances can be renewed", instance.UHostId, instance.ChargeType)
+	}
+
+	return nil
+}
+
+func resourceUHostRenewalRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	instance, err := describeInstance(apiClient, d.Get("uhost_id").(string))
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		log.Printf("[WARN] UHost renewal %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceUHostRenewalDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceUHostRenewal(t *testing.T) {
+	var before, after client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostRenewalConfig_pre, os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &before),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "charge_type", "Month"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "auto_renew", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostRenewalConfig, os.Getenv("UCLOUD_ZONE")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &after),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "auto_renew", "false"),
+					testAccCheckUHostRenewalExtended("ucloud_uhost_renewal.foo", &before),
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostRenewalConfig_pre = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = 1
+	memory = 1024
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Month"
+	quantity = 1
+	auto_renew = true
+}
+`
+
+const testAccUHostRenewalConfig = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = 1
+	memory = 1024
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Month"
+	quantity = 1
+	auto_renew = false
+}
+
+resource "ucloud_uhost_renewal" "foo" {
+	uhost_id = "${ucloud_uhost.foo.id}"
+	quantity = 1
+}
+`
+
+func testAccCheckUHostRenewalExtended(n string, before *client.UHostInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		expireTime, err := strconv.Atoi(rs.Primary.Attributes["expire_time"])
+		if err != nil {
+			return err
+		}
+		if expireTime <= before.ExpireTime {
+			return fmt.Errorf("Expect expire time to be later than %d but got %d", before.ExpireTime, expireTime)
+		}
+
+		return nil
+	}
+}
+
+func TestValidateUHostRenewable(t *testing.T) {
+	for chargeType, renewable := range map[string]bool{"Year": true, "Month": true, "Dynamic": false, "Trial": false} {
+		err := validateUHostRenewable(&client.UHostInstance{UHostId: "uhost-foo", ChargeType: chargeType})
+		if renewable && err != nil {
+			t.Errorf("Expect %s instance to be renewable but got: %s", chargeType, err)
+		} else if !renewable && err == nil {
+			t.Errorf("Expect %s instance not to be renewable", chargeType)
+		}
+	}
+}
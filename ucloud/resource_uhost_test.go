@@ -3,6 +3,7 @@ package ucloud
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
@@ -35,6 +36,7 @@ func TestAccResourceUHost(t *testing.T) {
 					testDataDeviceSize("ucloud_uhost.foo", "10"),
 					resource.TestCheckResourceAttr("ucloud_uhost.foo", "ip_set.#", "1"),
 					resource.TestCheckResourceAttr("ucloud_uhost.foo", "ip_set.0.type", "Private"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "user_data", userDataHashSum("#!/bin/sh\ntouch /tmp/foo\n")),
 				),
 			},
 			resource.TestStep{
@@ -86,6 +88,7 @@ resource "ucloud_uhost" "foo" {
 	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
 	image_id = "uimage-j4fbrn"
 	charge_type = "Dynamic"
+	user_data = "#!/bin/sh\ntouch /tmp/foo\n"
 }
 `
 
@@ -101,9 +104,290 @@ resource "ucloud_uhost" "foo" {
 	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
 	image_id = "uimage-j4fbrn"
 	charge_type = "Dynamic"
+	user_data = "#!/bin/sh\ntouch /tmp/foo\n"
 }
 `
 
+func TestAccResourceUHost_disks(t *testing.T) {
+	var host client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_uhost.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckUHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostDisksConfig, os.Getenv("UCLOUD_ZONE"), 40, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "boot_disk.#", "1"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "boot_disk.0.type", "CLOUD_SSD"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "boot_disk.0.size", "40"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.#", "2"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.0.type", "CLOUD_SSD"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.0.size", "20"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.1.type", "CLOUD_NORMAL"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.1.size", "50"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostDisksConfig, os.Getenv("UCLOUD_ZONE"), 50, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "boot_disk.0.size", "50"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.0.size", "30"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "data_disk.1.size", "50"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostDisksConfig = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = 1
+	memory = 1024
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Dynamic"
+
+	boot_disk {
+		type = "CLOUD_SSD"
+		size = %d
+	}
+
+	data_disk {
+		type = "CLOUD_SSD"
+		size = %d
+	}
+
+	data_disk {
+		type = "CLOUD_NORMAL"
+		size = 50
+	}
+}
+`
+
+func TestAccResourceUHost_reinstall(t *testing.T) {
+	var before, after client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnv(t, "UCLOUD_REINSTALL_IMAGE_ID") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostReinstallConfig, os.Getenv("UCLOUD_ZONE"), "uimage-j4fbrn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &before),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "image_id", "uimage-j4fbrn"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostReinstallConfig, os.Getenv("UCLOUD_ZONE"), os.Getenv("UCLOUD_REINSTALL_IMAGE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &after),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "image_id", os.Getenv("UCLOUD_REINSTALL_IMAGE_ID")),
+					func(*terraform.State) error {
+						if before.UHostId != after.UHostId {
+							return fmt.Errorf("Expect instance %s to be reinstalled but got a new one %s", before.UHostId, after.UHostId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostReinstallConfig = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = 1
+	memory = 1024
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "%s"
+	charge_type = "Dynamic"
+	reinstall_on_image_change = true
+
+	timeouts {
+		update = "30m"
+	}
+}
+`
+
+func TestAccResourceUHost_powerState(t *testing.T) {
+	var host client.UHostInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "ucloud_uhost.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckUHostDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostPowerStateConfig, os.Getenv("UCLOUD_ZONE"), 1, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "power_state", "stopped"),
+				),
+			},
+			// resize keeps the host stopped
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostPowerStateConfig, os.Getenv("UCLOUD_ZONE"), 2, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "cpu", "2"),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "power_state", "stopped"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccUHostPowerStateConfig, os.Getenv("UCLOUD_ZONE"), 2, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUHostExists("ucloud_uhost.foo", &host),
+					resource.TestCheckResourceAttr("ucloud_uhost.foo", "power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUHostPowerStateConfig = `
+resource "ucloud_uhost" "foo" {
+	zone = "%s"
+	name = "foo"
+	cpu = %d
+	memory = 2048
+	disk_space = 10
+	password = "dGVycmFmb3JtLXByb3ZpZGVyLXVjbG91ZA=="
+	image_id = "uimage-j4fbrn"
+	charge_type = "Dynamic"
+	power_state = "%s"
+}
+`
+
+func TestReadDataDisks(t *testing.T) {
+	instance := &client.UHostInstance{
+		DiskSet: []client.UHostDisk{
+			{Type: "Boot", DiskType: "CLOUD_SSD", IsBoot: "True", DiskId: "bsi-boot", Size: 40},
+			{Type: "Udisk", DiskType: "CLOUD_SSD", IsBoot: "False", DiskId: "bsi-a", Size: 20},
+			{Type: "Udisk", DiskType: "CLOUD_NORMAL", IsBoot: "False", DiskId: "bsi-b", Size: 50},
+			{Type: "Udisk", DiskType: "CLOUD_NORMAL", IsBoot: "False", DiskId: "bsi-c", Size: 100},
+		},
+	}
+
+	ids := func(disks []map[string]interface{}) []string {
+		ret := make([]string, 0, len(disks))
+		for _, disk := range disks {
+			ret = append(ret, disk["disk_id"].(string))
+		}
+		return ret
+	}
+
+	cases := []struct {
+		Configured []interface{}
+		Expected   []string
+	}{
+		// nothing configured such as on import
+		{nil, []string{"bsi-a", "bsi-b", "bsi-c"}},
+		// just created
+		{
+			[]interface{}{
+				map[string]interface{}{"disk_id": ""},
+				map[string]interface{}{"disk_id": ""},
+			},
+			[]string{"bsi-a", "bsi-b"},
+		},
+		// known ids win over DiskSet order
+		{
+			[]interface{}{
+				map[string]interface{}{"disk_id": "bsi-c"},
+				map[string]interface{}{"disk_id": ""},
+			},
+			[]string{"bsi-c", "bsi-a"},
+		},
+	}
+
+	for _, tc := range cases {
+		real := ids(readDataDisks(tc.Configured, instance))
+		if !reflect.DeepEqual(real, tc.Expected) {
+			t.Errorf("Expect data disks %v but got %v", tc.Expected, real)
+		}
+	}
+
+	bootDisk := readBootDisk(instance)
+	if len(bootDisk) != 1 || bootDisk[0]["disk_id"] != "bsi-boot" {
+		t.Errorf("Expect boot disk bsi-boot but got %v", bootDisk)
+	}
+}
+
+// fakeUHostChanges maps keys to their old and new values.
+type fakeUHostChanges map[string][2]interface{}
+
+func (c fakeUHostChanges) Get(key string) interface{} {
+	if v, ok := c[key]; ok {
+		return v[1]
+	}
+	if key == "data_disk" {
+		return []interface{}{}
+	}
+	return nil
+}
+
+func (c fakeUHostChanges) GetChange(key string) (interface{}, interface{}) {
+	v := c[key]
+	return v[0], v[1]
+}
+
+func (c fakeUHostChanges) HasChange(key string) bool {
+	v, ok := c[key]
+	return ok && !reflect.DeepEqual(v[0], v[1])
+}
+
+func TestUHostResizeNeedsStop(t *testing.T) {
+	hotplug := &client.UHostInstance{State: "Running", HotplugFeature: true}
+	noHotplug := &client.UHostInstance{State: "Running"}
+	stopped := &client.UHostInstance{State: "Stopped"}
+
+	cloudDataDisk := fakeUHostChanges{
+		"data_disk":        {[]interface{}{nil}, []interface{}{nil}},
+		"data_disk.0.type": {"CLOUD_SSD", "CLOUD_SSD"},
+		"data_disk.0.size": {20, 30},
+	}
+	localDataDisk := fakeUHostChanges{
+		"data_disk":        {[]interface{}{nil}, []interface{}{nil}},
+		"data_disk.0.type": {"LOCAL_NORMAL", "LOCAL_NORMAL"},
+		"data_disk.0.size": {20, 30},
+	}
+
+	cases := []struct {
+		Name     string
+		Instance *client.UHostInstance
+		Changes  fakeUHostChanges
+		Expected bool
+	}{
+		{"grow cpu online", hotplug, fakeUHostChanges{"cpu": {1, 2}}, false},
+		{"shrink memory", hotplug, fakeUHostChanges{"memory": {4096, 2048}}, true},
+		{"grow cpu without hotplug", noHotplug, fakeUHostChanges{"cpu": {1, 2}}, true},
+		{"already stopped", stopped, fakeUHostChanges{"cpu": {2, 1}}, false},
+		{"local data disk", hotplug, fakeUHostChanges{"disk_space": {20, 30}}, true},
+		{"boot disk", hotplug, fakeUHostChanges{"boot_disk.0.size": {20, 40}}, true},
+		{"cloud data disk", noHotplug, cloudDataDisk, false},
+		{"local data disk block", hotplug, localDataDisk, true},
+	}
+
+	for _, tc := range cases {
+		if real := uhostResizeNeedsStop(tc.Instance, tc.Changes); real != tc.Expected {
+			t.Errorf("%s: expect needing stop to be %t but got %t", tc.Name, tc.Expected, real)
+		}
+	}
+}
+
 func testAccCheckUHostDestroy(s *terraform.State) error {
 	return testAccCheckUHostDestroyWithProvider(s, testAccProvider)
 }
@@ -185,3 +469,40 @@ func testDataDeviceSize(n string, expectSize string) resource.TestCheckFunc {
 		return fmt.Errorf("Not found data disk with size %s", expectSize)
 	}
 }
+
+func TestResourceUHostDiffQuantity(t *testing.T) {
+	cases := []struct {
+		OldQuantity string
+		ChargeType  string
+		Quantity    int
+		Error       string
+	}{
+		{"1", "Month", 1, ""},
+		{"1", "Year", 2, ""},
+		{"1", "Month", 2, "use ucloud_uhost_renewal"},
+		// imported hosts have no quantity in state
+		{"", "Month", 2, ""},
+	}
+
+	for _, tc := range cases {
+		state := &terraform.InstanceState{
+			ID: "uhost-foo",
+			Attributes: map[string]string{
+				"zone":        "cn-bj2-02",
+				"image_id":    "uimage-foo",
+				"charge_type": "Month",
+			},
+		}
+		if tc.OldQuantity != "" {
+			state.Attributes["quantity"] = tc.OldQuantity
+		}
+		raw := map[string]interface{}{
+			"zone":        "cn-bj2-02",
+			"image_id":    "uimage-foo",
+			"charge_type": tc.ChargeType,
+			"quantity":    tc.Quantity,
+		}
+
+		testResourceDiff(t, resourceUHost(), state, raw, &client.Client{}, tc.Error)
+	}
+}
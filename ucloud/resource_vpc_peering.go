@@ -0,0 +1,227 @@
+package ucloud
+
+import (
+	"log"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceVPCPeering() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCPeeringCreate,
+		Read:   resourceVPCPeeringRead,
+		Delete: resourceVPCPeeringDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVPCPeeringImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"peer_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"peer_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region of the peer VPC, defaults to the provider region",
+			},
+
+			"peer_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Project of the peer VPC, defaults to the provider project",
+			},
+
+			"peer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"peer_network": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	params := client.CreateVPCIntercomRequest{
+		VPCId:    d.Get("vpc_id").(string),
+		DstVPCId: d.Get("peer_vpc_id").(string),
+	}
+	if v, ok := d.GetOk("peer_region"); ok {
+		params.DstRegion = v.(string)
+	}
+	if v, ok := d.GetOk("peer_project_id"); ok {
+		params.DstProjectId = v.(string)
+	}
+
+	var resp client.CreateVPCIntercomResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildCompositeId(params.VPCId, params.DstVPCId))
+
+	return resourceVPCPeeringRead(d, meta)
+}
+
+func resourceVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	vpcId, peerVPCId, err := parseVPCPeeringId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vpc, err := describeVPC(apiClient, vpcId)
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		log.Printf("[WARN] VPC %s is gone, removing VPC peering %s from state", vpcId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	intercom, err := describeVPCIntercom(apiClient, vpcId, peerVPCId, d.Get("peer_region").(string), d.Get("peer_project_id").(string))
+	if err != nil {
+		return err
+	}
+	if intercom == nil {
+		log.Printf("[WARN] VPC peering %s is gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_id", vpcId)
+	d.Set("peer_vpc_id", intercom.VPCId)
+	d.Set("peer_region", intercom.DstRegion)
+	d.Set("peer_project_id", intercom.ProjectId)
+	d.Set("peer_name", intercom.Name)
+	d.Set("peer_network", intercom.Network)
+
+	return nil
+}
+
+// resourceVPCPeeringImport accepts <vpc_id>:<peer_vpc_id> for a peer VPC in
+// the provider region and project, and
+// <vpc_id>:<peer_vpc_id>:<peer_region>:<peer_project_id> for the others.
+func resourceVPCPeeringImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if parts, err := parseCompositeId(d.Id(), "vpc_id", "peer_vpc_id", "peer_region", "peer_project_id"); err == nil {
+		d.SetId(buildCompositeId(parts[0], parts[1]))
+		d.Set("peer_region", parts[2])
+		d.Set("peer_project_id", parts[3])
+	}
+
+	_, _, err := parseVPCPeeringId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVPCPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	apiClient := meta.(*client.Client)
+
+	vpcId, peerVPCId, err := parseVPCPeeringId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vpc, err := describeVPC(apiClient, vpcId)
+	if err != nil {
+		return err
+	}
+	// the peering is removed together with the VPC
+	if vpc == nil {
+		d.SetId("")
+		return nil
+	}
+
+	params := client.DeleteVPCIntercomRequest{
+		VPCId:        vpcId,
+		DstVPCId:     peerVPCId,
+		DstRegion:    d.Get("peer_region").(string),
+		DstProjectId: d.Get("peer_project_id").(string),
+	}
+	var resp client.DeleteVPCIntercomResponse
+	err = apiClient.Call(&params, &resp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseVPCPeeringId(id string) (string, string, error) {
+	parts, err := parseCompositeId(id, "vpc_id", "peer_vpc_id")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func describeVPC(apiClient *client.Client, vpcId string) (*client.VPC, error) {
+	params := client.DescribeVPCRequest{
+		VPCIds: []string{vpcId},
+	}
+
+	var resp client.DescribeVPCResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].VPCId == vpcId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func describeVPCIntercom(apiClient *client.Client, vpcId, peerVPCId, peerRegion, peerProjectId string) (*client.VPCIntercom, error) {
+	params := client.DescribeVPCIntercomRequest{
+		VPCId:        vpcId,
+		DstRegion:    peerRegion,
+		DstProjectId: peerProjectId,
+	}
+
+	var resp client.DescribeVPCIntercomResponse
+	err := apiClient.Call(&params, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.DataSet {
+		if resp.DataSet[i].VPCId == peerVPCId {
+			return &resp.DataSet[i], nil
+		}
+	}
+
+	return nil, nil
+}
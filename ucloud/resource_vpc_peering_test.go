@@ -0,0 +1,114 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/3pjgames/terraform-provider-ucloud/ucloud/client"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceVPCPeering(t *testing.T) {
+	var intercom client.VPCIntercom
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheckEnv(t, "UCLOUD_VPC_ID", "UCLOUD_PEER_VPC_ID") },
+		IDRefreshName: "ucloud_vpc_peering.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVPCPeeringDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccVPCPeeringConfig, os.Getenv("UCLOUD_VPC_ID"), os.Getenv("UCLOUD_PEER_VPC_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringExists("ucloud_vpc_peering.foo", &intercom),
+					resource.TestCheckResourceAttr("ucloud_vpc_peering.foo", "vpc_id", os.Getenv("UCLOUD_VPC_ID")),
+					resource.TestCheckResourceAttr("ucloud_vpc_peering.foo", "peer_vpc_id", os.Getenv("UCLOUD_PEER_VPC_ID")),
+					resource.TestCheckResourceAttr("ucloud_vpc_peering.foo", "peer_region", os.Getenv("UCLOUD_REGION")),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ucloud_vpc_peering.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVPCPeeringImportStateId("ucloud_vpc_peering.foo"),
+			},
+		},
+	})
+}
+
+const testAccVPCPeeringConfig = `
+resource "ucloud_vpc_peering" "foo" {
+	vpc_id = "%s"
+	peer_vpc_id = "%s"
+}
+`
+
+// testAccVPCPeeringImportStateId returns the long form of the id, with
+// peer_region and peer_project_id.
+func testAccVPCPeeringImportStateId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return buildCompositeId(rs.Primary.ID, rs.Primary.Attributes["peer_region"], rs.Primary.Attributes["peer_project_id"]), nil
+	}
+}
+
+func testAccCheckVPCPeeringDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_vpc_peering" {
+			continue
+		}
+
+		vpcId, peerVPCId, err := parseVPCPeeringId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		intercom, err := describeVPCIntercom(apiClient, vpcId, peerVPCId, rs.Primary.Attributes["peer_region"], rs.Primary.Attributes["peer_project_id"])
+		if err != nil {
+			return err
+		}
+		if intercom != nil {
+			return fmt.Errorf("Found undeleted VPC peering: %+v", intercom)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVPCPeeringExists(n string, i *client.VPCIntercom) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		vpcId, peerVPCId, err := parseVPCPeeringId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		apiClient := testAccProvider.Meta().(*client.Client)
+		intercom, err := describeVPCIntercom(apiClient, vpcId, peerVPCId, rs.Primary.Attributes["peer_region"], rs.Primary.Attributes["peer_project_id"])
+		if err != nil {
+			return err
+		}
+		if intercom == nil {
+			return fmt.Errorf("VPC peering not found")
+		}
+
+		*i = *intercom
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package ucloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func expandStringSet(set *schema.Set) []string {
+	list := set.List()
+	ret := make([]string, 0, len(list))
+	for _, v := range list {
+		ret = append(ret, v.(string))
+	}
+
+	return ret
+}
+
+func formatUnixTime(t int) string {
+	return time.Unix(int64(t), 0).Format(time.RFC3339)
+}
+
+// suppressAfterCreate ignores changes of arguments which are only used when
+// creating the resource and cannot be read back, such as quantity.
+func suppressAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// suppressEquivalentRFC3339Time ignores the difference between two times in
+// different time zones, such as a configured time and the one read back.
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// dataSourceSchemaFromResource copies keys of the schema of a resource as
+// computed attributes, so that a data source reads the same attributes.
+func dataSourceSchemaFromResource(resourceSchema map[string]*schema.Schema, keys ...string) map[string]*schema.Schema {
+	ret := make(map[string]*schema.Schema, len(keys))
+	for _, key := range keys {
+		ret[key] = computedSchema(resourceSchema[key])
+	}
+
+	return ret
+}
+
+func computedSchema(s *schema.Schema) *schema.Schema {
+	ret := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Description: s.Description,
+		Set:         s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		fields := make(map[string]*schema.Schema, len(elem.Schema))
+		for key, field := range elem.Schema {
+			fields[key] = computedSchema(field)
+		}
+		ret.Elem = &schema.Resource{Schema: fields}
+	case *schema.Schema:
+		ret.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return ret
+}
@@ -0,0 +1,129 @@
+package ucloud
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func validateAllowedStringValue(values []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		for _, allowed := range values {
+			if value == allowed {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%s must be one of %s, got %q", k, strings.Join(values, ", "), value))
+		return
+	}
+}
+
+func validateIntBetween(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf("%s must be between %d and %d, got %d", k, min, max, value))
+		}
+
+		return
+	}
+}
+
+// validateCIDRNetwork accepts an IPv4 network in CIDR notation whose host
+// bits are all zero, such as 10.9.0.0/16.
+func validateCIDRNetwork(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ip, ipnet, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a network in CIDR notation, got %q", k, value))
+		return
+	}
+
+	if ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%s must be an IPv4 network, got %q", k, value))
+	} else if !ip.Equal(ipnet.IP) {
+		errors = append(errors, fmt.Errorf("%s has host bits set, use %s instead of %q", k, ipnet.String(), value))
+	}
+
+	return
+}
+
+// validatePortRange accepts a single port such as 80 or a range such as
+// 8000-8010.
+func validatePortRange(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	bounds := strings.Split(value, "-")
+	if len(bounds) > 2 {
+		errors = append(errors, fmt.Errorf("%s must be a port or a port range such as 8000-8010, got %q", k, value))
+		return
+	}
+
+	ports := make([]int, 0, 2)
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(bound)
+		if err != nil || port < 1 || port > 65535 {
+			errors = append(errors, fmt.Errorf("%s must be a port or a port range such as 8000-8010, got %q", k, value))
+			return
+		}
+		ports = append(ports, port)
+	}
+
+	if len(ports) == 2 && ports[0] > ports[1] {
+		errors = append(errors, fmt.Errorf("%s has a port range in reverse order: %q", k, value))
+	}
+
+	return
+}
+
+// validatePEM does not print the value, which may be a private key.
+func validatePEM(v interface{}, k string) (ws []string, errors []error) {
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil {
+		errors = append(errors, fmt.Errorf("%s must be PEM encoded", k))
+	}
+
+	return
+}
+
+func validateRFC3339Time(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a time in RFC 3339 such as 2018-06-01T20:00:00+08:00, got %q", k, value))
+	}
+
+	return
+}
+
+// maxUserDataSize is the limit of UserData, which is sent base64 encoded.
+const maxUserDataSize = 16 * 1024
+
+func validateUserData(v interface{}, k string) (ws []string, errors []error) {
+	size := base64.StdEncoding.EncodedLen(len(v.(string)))
+	if size > maxUserDataSize {
+		errors = append(errors, fmt.Errorf("%s must be at most %d bytes once base64 encoded, got %d", k, maxUserDataSize, size))
+	}
+
+	return
+}
+
+func validateUserDataBase64(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be base64 encoded: %s", k, err))
+		return
+	}
+
+	if len(value) > maxUserDataSize {
+		errors = append(errors, fmt.Errorf("%s must be at most %d bytes, got %d", k, maxUserDataSize, len(value)))
+	}
+
+	return
+}
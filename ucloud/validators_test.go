@@ -0,0 +1,128 @@
+package ucloud
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateCIDRNetwork(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{"10.9.0.0/16", true},
+		{"0.0.0.0/0", true},
+		{"192.168.1.1/32", true},
+		{"10.9.0.1/16", false},
+		{"10.9.0.0", false},
+		{"10.9.0.0/33", false},
+		{"fd00::/8", false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCIDRNetwork(tc.Value, "cidr_block")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Errorf("Expect validity of %q to be %t but got errors: %v", tc.Value, tc.Valid, errors)
+		}
+	}
+}
+
+func TestValidatePortRange(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{"80", true},
+		{"1-65535", true},
+		{"8000-8000", true},
+		{"0", false},
+		{"65536", false},
+		{"8010-8000", false},
+		{"80-", false},
+		{"1-2-3", false},
+		{"http", false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validatePortRange(tc.Value, "port_range")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Errorf("Expect validity of %q to be %t but got errors: %v", tc.Value, tc.Valid, errors)
+		}
+	}
+}
+
+func TestValidateAllowedStringValue(t *testing.T) {
+	validate := validateAllowedStringValue([]string{"Ingress", "Egress"})
+
+	if _, errors := validate("Ingress", "direction"); len(errors) != 0 {
+		t.Error("Expect Ingress to be valid: ", errors)
+	}
+	if _, errors := validate("ingress", "direction"); len(errors) == 0 {
+		t.Error("Expect ingress to be invalid")
+	}
+}
+
+func TestValidatePEM(t *testing.T) {
+	if _, errors := validatePEM("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n", "user_cert"); len(errors) != 0 {
+		t.Error("Expect PEM block to be valid: ", errors)
+	}
+
+	_, errors := validatePEM("secret", "private_key")
+	if len(errors) == 0 {
+		t.Fatal("Expect non PEM value to be invalid")
+	}
+	if strings.Contains(errors[0].Error(), "secret") {
+		t.Error("Expect error not to leak the value: ", errors[0])
+	}
+}
+
+func TestValidateRFC3339Time(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{"2018-06-01T20:00:00+08:00", true},
+		{"2018-06-01T12:00:00Z", true},
+		{"2018-06-01 20:00:00", false},
+		{"2018-06-01", false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateRFC3339Time(tc.Value, "enable_time")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Errorf("Expect validity of %q to be %t but got errors: %v", tc.Value, tc.Valid, errors)
+		}
+	}
+}
+
+func TestValidateUserData(t *testing.T) {
+	if _, errors := validateUserData("#cloud-config\n", "user_data"); len(errors) != 0 {
+		t.Error("Expect small user data to be valid: ", errors)
+	}
+	// 12288 bytes is exactly 16K once encoded
+	if _, errors := validateUserData(strings.Repeat("a", 12288), "user_data"); len(errors) != 0 {
+		t.Error("Expect user data of 16K encoded to be valid: ", errors)
+	}
+	if _, errors := validateUserData(strings.Repeat("a", 12289), "user_data"); len(errors) == 0 {
+		t.Error("Expect user data over 16K encoded to be invalid")
+	}
+}
+
+func TestValidateUserDataBase64(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{"I2Nsb3VkLWNvbmZpZwo=", true},
+		{"#cloud-config", false},
+		{strings.Repeat("YWFh", 4096), true},
+		{strings.Repeat("YWFh", 4097), false},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateUserDataBase64(tc.Value, "user_data_base64")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Errorf("Expect validity of %.20q to be %t but got errors: %v", tc.Value, tc.Valid, errors)
+		}
+	}
+}
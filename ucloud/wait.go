@@ -0,0 +1,21 @@
+package ucloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// newStateChangeConf builds the StateChangeConf of every waiter. timeout is
+// read by the caller with d.Timeout, so that it follows the timeouts block
+// of the resource.
+func newStateChangeConf(pending, target []string, refresh resource.StateRefreshFunc, timeout time.Duration) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+}